@@ -1,32 +1,48 @@
 package buildkite
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"regexp"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	genqlient "github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/shurcooL/graphql"
 )
 
 // Client can be used to interact with the Buildkite API
 type Client struct {
-	graphql        *graphql.Client
 	genqlient      genqlient.Client
 	http           *http.Client
 	organization   string
 	organizationId *string
 	restURL        string
 	timeouts       timeouts.Value
+	// tokenScopes holds the REST API scopes of the API token. It is nil until the token has been validated.
+	tokenScopes   []string
+	scopeWarnings *sync.Map
+}
+
+// AccessTokenResponse is the REST API representation of the API token in use
+type AccessTokenResponse struct {
+	UUID        string   `json:"uuid"`
+	Scopes      []string `json:"scopes"`
+	Description string   `json:"description"`
+	User        struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"user"`
 }
 
 type clientConfig struct {
@@ -36,6 +52,13 @@ type clientConfig struct {
 	restURL    string
 	userAgent  string
 	timeouts   timeouts.Value
+
+	// network settings applied to both the GraphQL and REST transports
+	proxyURL           string
+	caCertPEM          []byte
+	clientCertPEM      []byte
+	clientKeyPEM       []byte
+	insecureSkipVerify bool
 }
 
 type headerRoundTripper struct {
@@ -47,7 +70,7 @@ func (client Client) GetOrganizationID() (*string, error) {
 	if client.organizationId != nil {
 		return client.organizationId, nil
 	}
-	orgId, err := GetOrganizationID(client.organization, client.graphql)
+	orgId, err := GetOrganizationID(client.organization, client.genqlient)
 	client.organizationId = &orgId
 	if err != nil {
 		return nil, err
@@ -69,10 +92,19 @@ func (client Client) GetOrganizationID() (*string, error) {
 //     - Falls back to Retry-After header if reset time isn't available
 //  4. Also retries server errors (HTTP 500-599) with linear jitter backoff
 //  5. All retryable requests have a minimum wait of 15 seconds and maximum of 180 seconds
+//
+// Both the GraphQL and REST clients share a transport built from the proxy and TLS settings in config.
+
+func NewClient(config *clientConfig) (*Client, error) {
+	transport, err := newTransport(config)
+	if err != nil {
+		return nil, err
+	}
 
-func NewClient(config *clientConfig) *Client {
 	// Create standard HTTP client for GraphQL
-	standardHttpClient := &http.Client{}
+	standardHttpClient := &http.Client{
+		Transport: transport,
+	}
 
 	// Set timeout if configured
 	readTimeout, diags := config.timeouts.Read(context.Background(), DefaultTimeout)
@@ -89,6 +121,7 @@ func NewClient(config *clientConfig) *Client {
 	// Create retryable client with rate limit handling for REST API calls
 	retryClient := retryablehttp.NewClient()
 
+	retryClient.HTTPClient.Transport = transport
 	retryClient.RetryMax = 10
 	retryClient.RetryWaitMin = 15 * time.Second
 	retryClient.RetryWaitMax = 180 * time.Second
@@ -143,6 +176,10 @@ func NewClient(config *clientConfig) *Client {
 	}
 
 	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if isPlanLookup(ctx) {
+			return false, nil
+		}
+
 		if err != nil || resp == nil {
 			return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 		}
@@ -162,136 +199,210 @@ func NewClient(config *clientConfig) *Client {
 	retryClient.HTTPClient.Transport = newHeaderRoundTripper(retryClient.HTTPClient.Transport, header)
 	restHttpClient := retryClient.StandardClient()
 
-	// Create GraphQL client with standard HTTP client (no rate limit handling)
-	graphqlClient := graphql.NewClient(config.graphqlURL, standardHttpClient)
-
 	return &Client{
-		graphql:        graphqlClient,
-		genqlient:      genqlient.NewClient(config.graphqlURL, standardHttpClient),
+		// GraphQL requests use the standard HTTP client (no rate limit handling)
+		genqlient:      genqlient.NewClient(config.graphqlURL, newGraphQLDoer(standardHttpClient)),
 		http:           restHttpClient, // For REST API calls with rate limit handling
 		organization:   config.org,
 		organizationId: nil,
 		restURL:        config.restURL,
 		timeouts:       config.timeouts,
-	}
+		scopeWarnings:  &sync.Map{},
+	}, nil
 }
 
-func newHeaderRoundTripper(next http.RoundTripper, header http.Header) *headerRoundTripper {
-	if next == nil {
-		next = http.DefaultTransport
-	}
-	return &headerRoundTripper{
-		next:   next,
-		Header: header,
-	}
+// planLookupKey marks the context of an API lookup made while planning
+type planLookupKey struct{}
+
+// withPlanLookup bounds ctx by planLookupTimeout and stops REST requests made with it from being retried, so that a
+// lookup made while planning fails quickly when the API is unavailable
+func withPlanLookup(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithValue(ctx, planLookupKey{}, true), planLookupTimeout)
 }
 
-func (rt *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if rt.Header != nil {
-		for k, v := range rt.Header {
-			req.Header[k] = v
-		}
-	}
-	return rt.next.RoundTrip(req)
+func isPlanLookup(ctx context.Context) bool {
+	lookup, _ := ctx.Value(planLookupKey{}).(bool)
+	return lookup
 }
 
-// GraphQL-specific retry functions
+// newTransport creates the HTTP transport used for all API requests, applying any configured proxy, custom CA
+// certificates and client certificate.
+func newTransport(config *clientConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
 
-func isRetryableError(err error) bool {
-	return isRateLimited(err) || isServerError(err)
-}
+	if config.proxyURL != "" {
+		proxy, err := url.Parse(config.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: a scheme and host are required", proxy.Redacted())
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	// verification is only skipped when explicitly requested, e.g. for a local stand-in of the API
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
 
-func isRateLimited(err error) bool {
-	// see: https://github.com/Khan/genqlient/blob/main/graphql/client.go#L167
-	r := regexp.MustCompile(`returned error (\d{3}):`)
-	if match := r.FindString(err.Error()); match != "" {
-		code, _ := strconv.Atoi(match)
-		if code == http.StatusTooManyRequests {
-			return true
+	if len(config.caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.caCertPEM) {
+			return nil, errors.New("no valid PEM encoded certificates were found in the CA certificates")
 		}
+		tlsConfig.RootCAs = pool
 	}
-	return false
-}
 
-func isServerError(err error) bool {
-	// see: https://github.com/Khan/genqlient/blob/main/graphql/client.go#L167
-	r := regexp.MustCompile(`returned error (\d{3}):`)
-	if match := r.FindString(err.Error()); match != "" {
-		code, _ := strconv.Atoi(match)
-		if code >= http.StatusBadGateway && code <= http.StatusGatewayTimeout {
-			return true
+	if len(config.clientCertPEM) > 0 || len(config.clientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(config.clientCertPEM, config.clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
 		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
-	return false
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
 }
 
-// NOTE: retryContextError function is defined in util.go and used for GraphQL retries
+// validateToken confirms the API token can access the configured organization, which is the only problem reported as
+// an error. The token's details are read so its REST API scopes can be retained for resources to warn about any scopes
+// they need but are missing, but tokens used through a proxy may not be able to read them, so failing to is a warning.
+func (client *Client) validateToken(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var token AccessTokenResponse
 
-func (client *Client) makeRequest(ctx context.Context, method string, path string, postData interface{}, responseObject interface{}) error {
-	readTimeout, diags := client.timeouts.Read(ctx, DefaultTimeout)
-	if !diags.HasError() {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, readTimeout)
-		defer cancel()
-	}
+	// the configured read timeout applies to resources, not to setting up the provider
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
 
-	bodyBytes := io.Reader(nil)
-	if postData != nil {
-		jsonPayload, err := json.Marshal(postData)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request: %w", err)
+	err := client.doRequest(ctx, http.MethodGet, "/v2/access-token", nil, &token)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read API token details",
+			fmt.Sprintf("Unable to read the API token details from the Buildkite API, so its scopes cannot be checked: %s",
+				describeError(err)),
+		)
+	} else {
+		client.tokenScopes = token.Scopes
+
+		if !slices.Contains(token.Scopes, "graphql") {
+			diags.AddWarning(
+				"API token is missing the graphql scope",
+				fmt.Sprintf("The API token %s does not report GraphQL API access, which is required by this provider. "+
+					"Enable GraphQL API access for the token in your API access token settings.", token.UUID),
+			)
 		}
-		bodyBytes = bytes.NewBuffer(jsonPayload)
 	}
 
-	url := fmt.Sprintf("%s%s", client.restURL, path)
+	org, err := getOrganization(ctx, client.genqlient, client.organization)
+	switch kind := classifyError(err); {
+	case err == nil && org.Organization.Id == "":
+		diags.AddError(
+			"Unable to access organization",
+			fmt.Sprintf("The API token cannot access the organization %q: organization not found", client.organization),
+		)
+	case err == nil:
+		client.organizationId = &org.Organization.Id
+	case kind == errorKindNotFound || kind == errorKindPermissionDenied:
+		diags.AddError(
+			"Unable to access organization",
+			fmt.Sprintf("The API token cannot access the organization %q: %s", client.organization, describeError(err)),
+		)
+	default:
+		// the organization is looked up again when a resource needs it
+		diags.AddWarning(
+			"Unable to validate organization access",
+			fmt.Sprintf("Unable to confirm the API token can access the organization %q: %s", client.organization,
+				describeError(err)),
+		)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyBytes)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	return diags
+}
+
+// missingScopes returns the scopes from required that the API token does not have
+func (client *Client) missingScopes(required []string) []string {
+	var missing []string
+	for _, scope := range required {
+		if !slices.Contains(client.tokenScopes, scope) {
+			missing = append(missing, scope)
+		}
 	}
+	return missing
+}
 
-	// Add content-type header for POST/PUT requests with body
-	if (method == http.MethodPost || method == http.MethodPut) && bodyBytes != nil {
-		req.Header.Set("Content-Type", "application/json")
+// warnMissingScopes adds a warning to diags if the API token lacks any of the REST API scopes required by typeName.
+// Each type only warns once per provider instance. Only types that call the REST API need to use it: GraphQL API access
+// is granted by the graphql scope alone, which validateToken checks, and what a GraphQL request may do is then decided
+// by the permissions of the token's user rather than by scopes.
+func (client *Client) warnMissingScopes(typeName string, required []string, diags *diag.Diagnostics) {
+	if client.tokenScopes == nil {
+		return
 	}
 
-	resp, err := client.http.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+	missing := client.missingScopes(required)
+	if len(missing) == 0 {
+		return
 	}
 
-	if resp.StatusCode >= 400 {
-		defer resp.Body.Close()
+	if _, warned := client.scopeWarnings.LoadOrStore(typeName, true); warned {
+		return
+	}
 
-		// Try to read the error body for better error messages
-		var errorMsg string
-		errorBody, readErr := io.ReadAll(resp.Body)
-		if readErr == nil && len(errorBody) > 0 {
-			errorMsg = string(errorBody)
-		}
+	diags.AddWarning(
+		"API token is missing required scopes",
+		fmt.Sprintf("%s requires the API token to have the %s scopes, but it is missing: %s. Operations on %s may fail.",
+			typeName, strings.Join(required, ", "), strings.Join(missing, ", "), typeName),
+	)
+}
 
-		if errorMsg != "" {
-			return fmt.Errorf("the Buildkite API request failed: %s %s (status: %d): %s",
-				method, url, resp.StatusCode, errorMsg)
+func newHeaderRoundTripper(next http.RoundTripper, header http.Header) *headerRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &headerRoundTripper{
+		next:   next,
+		Header: header,
+	}
+}
+
+func (rt *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.Header != nil {
+		for k, v := range rt.Header {
+			req.Header[k] = v
 		}
-		return fmt.Errorf("the Buildkite API request failed: %s %s (status: %d)",
-			method, url, resp.StatusCode)
-	} else if resp.StatusCode == 204 {
-		resp.Body.Close()
-		return nil
 	}
+	return rt.next.RoundTrip(req)
+}
 
-	defer resp.Body.Close()
+// NOTE: retryContextError function is defined in util.go and used for GraphQL retries
 
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
+func (client *Client) makeRequest(ctx context.Context, method string, path string, postData interface{}, responseObject interface{}) error {
+	ctx, cancel := client.withReadTimeout(ctx)
+	defer cancel()
+
+	return client.doRequest(ctx, method, path, postData, responseObject)
+}
 
-	if err := json.Unmarshal(responseBody, responseObject); err != nil {
-		return fmt.Errorf("failed to unmarshal response: %w", err)
+// withReadTimeout applies the configured read timeout to REST API requests made with ctx
+func (client *Client) withReadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	readTimeout, diags := client.timeouts.Read(ctx, DefaultTimeout)
+	if diags.HasError() {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeout(ctx, readTimeout)
+}
 
-	return nil
+// doRequest performs a REST API request without applying any of the configured timeouts. Error responses are
+// returned as an *APIError.
+func (client *Client) doRequest(ctx context.Context, method string, path string, postData interface{}, responseObject interface{}) error {
+	_, err := client.send(ctx, method, client.restURL+path, postData, responseObject)
+	return err
 }
@@ -9,6 +9,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+type clusterDatasourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Emoji       types.String `tfsdk:"emoji"`
+	Color       types.String `tfsdk:"color"`
+	UUID        types.String `tfsdk:"uuid"`
+}
+
 type clusterDatasource struct {
 	client *Client
 }
@@ -30,7 +39,7 @@ func (*clusterDatasource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (c *clusterDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state clusterResourceModel
+	var state clusterDatasourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
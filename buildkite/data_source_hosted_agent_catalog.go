@@ -0,0 +1,220 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+
+	genqlient "github.com/Khan/genqlient/graphql"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	hostedAgentMachineTypeLinux = "LINUX"
+	hostedAgentMachineTypeMacOS = "MACOS"
+)
+
+// hostedAgentInstanceShapeName matches instance shape names such as LINUX_AMD64_2X4 and MACOS_M2_4X7, which give the
+// machine type, architecture or chip, vCPUs and memory in GB
+var hostedAgentInstanceShapeName = regexp.MustCompile(`^(LINUX|MACOS)_([A-Z0-9]+)_(\d+)X(\d+)$`)
+
+type hostedAgentCatalogDatasourceModel struct {
+	InstanceShapes []hostedAgentInstanceShapeModel `tfsdk:"instance_shapes"`
+}
+
+type hostedAgentInstanceShapeModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	MachineType  types.String `tfsdk:"machine_type"`
+	Architecture types.String `tfsdk:"architecture"`
+	Vcpu         types.Int64  `tfsdk:"vcpu"`
+	Memory       types.Int64  `tfsdk:"memory"`
+}
+
+type hostedAgentCatalogDatasource struct {
+	client *Client
+}
+
+func newHostedAgentCatalogDatasource() datasource.DataSource {
+	return &hostedAgentCatalogDatasource{}
+}
+
+func (h *hostedAgentCatalogDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	h.client = req.ProviderData.(*Client)
+}
+
+func (h *hostedAgentCatalogDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosted_agent_catalog"
+}
+
+func (h *hostedAgentCatalogDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to list the instance shapes available for Buildkite hosted agents. The shapes are read from
+			the API, so they include any added since this version of the provider was released.
+
+			The Xcode versions and Linux agent images available can't be listed, as the API does not expose them. See the
+			Buildkite [documentation](https://buildkite.com/docs/pipelines/hosted-agents) for those.
+		`),
+		Attributes: map[string]schema.Attribute{
+			"instance_shapes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instance shapes that can be used in the `hosted_agents` of a `buildkite_cluster_queue`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the instance shape, used as the `instance_shape` of a queue.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the instance shape.",
+						},
+						"machine_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of machine, either `LINUX` or `MACOS`.",
+						},
+						"architecture": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The architecture of the machine, either `AMD64` or `ARM64`.",
+						},
+						"vcpu": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of vCPUs of the instance shape.",
+						},
+						"memory": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The memory of the instance shape in GB.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (h *hostedAgentCatalogDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hostedAgentCatalogDatasourceModel
+
+	shapes, err := getHostedAgentInstanceShapes(ctx, h.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read hosted agent catalog",
+			fmt.Sprintf("Unable to read hosted agent instance shapes: %s", describeError(err)),
+		)
+		return
+	}
+
+	state.InstanceShapes = shapes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getHostedAgentInstanceShapesOperation reads the values of the HostedAgentInstanceShapeName enum from the schema.
+// Introspection is used as the API has no query listing the shapes, and genqlient can't generate introspection queries
+// so the request is written by hand.
+const getHostedAgentInstanceShapesOperation = `
+query getHostedAgentInstanceShapes {
+    __type(name: "HostedAgentInstanceShapeName") {
+        enumValues {
+            name
+            description
+        }
+    }
+}
+`
+
+type getHostedAgentInstanceShapesResponse struct {
+	Type *struct {
+		EnumValues []struct {
+			Name        string  `json:"name"`
+			Description *string `json:"description"`
+		} `json:"enumValues"`
+	} `json:"__type"`
+}
+
+// getHostedAgentInstanceShapes reads the instance shapes available for hosted agents from the API
+func getHostedAgentInstanceShapes(ctx context.Context, client *Client) ([]hostedAgentInstanceShapeModel, error) {
+	var data getHostedAgentInstanceShapesResponse
+	err := client.genqlient.MakeRequest(ctx, &genqlient.Request{
+		OpName: "getHostedAgentInstanceShapes",
+		Query:  getHostedAgentInstanceShapesOperation,
+	}, &genqlient.Response{Data: &data})
+	if err != nil {
+		return nil, err
+	}
+	if data.Type == nil {
+		return nil, fmt.Errorf("the HostedAgentInstanceShapeName type was not found in the schema")
+	}
+
+	shapes := make([]hostedAgentInstanceShapeModel, 0, len(data.Type.EnumValues))
+	for _, value := range data.Type.EnumValues {
+		shapes = append(shapes, newHostedAgentInstanceShape(value.Name, value.Description))
+	}
+	return shapes, nil
+}
+
+// newHostedAgentInstanceShape describes an instance shape from its name. Attributes that can't be derived from the
+// name are left null.
+func newHostedAgentInstanceShape(name string, description *string) hostedAgentInstanceShapeModel {
+	shape := hostedAgentInstanceShapeModel{
+		Name:         types.StringValue(name),
+		Description:  types.StringPointerValue(description),
+		MachineType:  types.StringNull(),
+		Architecture: types.StringNull(),
+		Vcpu:         types.Int64Null(),
+		Memory:       types.Int64Null(),
+	}
+	match := hostedAgentInstanceShapeName.FindStringSubmatch(name)
+	if match == nil {
+		return shape
+	}
+	shape.MachineType = types.StringValue(match[1])
+	if match[1] == hostedAgentMachineTypeMacOS {
+		// macOS shapes are named after their Apple silicon chip
+		shape.Architecture = types.StringValue("ARM64")
+	} else {
+		shape.Architecture = types.StringValue(match[2])
+	}
+	vcpu, _ := strconv.ParseInt(match[3], 10, 64)
+	memory, _ := strconv.ParseInt(match[4], 10, 64)
+	shape.Vcpu = types.Int64Value(vcpu)
+	shape.Memory = types.Int64Value(memory)
+	return shape
+}
+
+// builtInHostedAgentInstanceShapes returns the instance shapes known to this version of the provider
+func builtInHostedAgentInstanceShapes() []hostedAgentInstanceShapeModel {
+	shapes := make([]hostedAgentInstanceShapeModel, 0, len(MacInstanceShapes)+len(LinuxInstanceShapes))
+	for _, name := range slices.Concat(LinuxInstanceShapes, MacInstanceShapes) {
+		shapes = append(shapes, newHostedAgentInstanceShape(name, nil))
+	}
+	return shapes
+}
+
+// hostedAgentInstanceShapes returns the instance shapes in the catalog read from the API, which is the authority on
+// what is available. Only if the catalog can't be read are the shapes known to the provider returned instead.
+func hostedAgentInstanceShapes(ctx context.Context, client *Client) []hostedAgentInstanceShapeModel {
+	if client == nil {
+		return builtInHostedAgentInstanceShapes()
+	}
+
+	ctx, cancel := withPlanLookup(ctx)
+	defer cancel()
+	catalog, err := getHostedAgentInstanceShapes(ctx, client)
+	if err != nil {
+		log.Printf("Unable to read hosted agent instance shapes, using the shapes known to the provider: %s", err)
+		return builtInHostedAgentInstanceShapes()
+	}
+	return catalog
+}
@@ -0,0 +1,166 @@
+package buildkite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBuildkiteHostedAgentCatalogDatasource(t *testing.T) {
+	t.Run("hosted agent catalog lists the instance shapes", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `data "buildkite_hosted_agent_catalog" "catalog" {}`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							"data.buildkite_hosted_agent_catalog.catalog",
+							tfjsonpath.New("instance_shapes"),
+							knownvalue.ListPartial(map[int]knownvalue.Check{
+								0: knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"name":         knownvalue.StringExact(LinuxAMD64InstanceSmall),
+									"description":  knownvalue.StringExact("Linux 2 vCPU x 4 GB Memory"),
+									"machine_type": knownvalue.StringExact("LINUX"),
+									"architecture": knownvalue.StringExact("AMD64"),
+									"vcpu":         knownvalue.Int64Exact(2),
+									"memory":       knownvalue.Int64Exact(4),
+								}),
+							}),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestNewHostedAgentInstanceShape(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]hostedAgentInstanceShapeModel{
+		"LINUX_ARM64_8X32": {
+			MachineType:  types.StringValue("LINUX"),
+			Architecture: types.StringValue("ARM64"),
+			Vcpu:         types.Int64Value(8),
+			Memory:       types.Int64Value(32),
+		},
+		"MACOS_M4_12X56": {
+			MachineType:  types.StringValue("MACOS"),
+			Architecture: types.StringValue("ARM64"),
+			Vcpu:         types.Int64Value(12),
+			Memory:       types.Int64Value(56),
+		},
+		// a shape released since the provider is described from its name
+		"MACOS_M5_24X96": {
+			MachineType:  types.StringValue("MACOS"),
+			Architecture: types.StringValue("ARM64"),
+			Vcpu:         types.Int64Value(24),
+			Memory:       types.Int64Value(96),
+		},
+		"GPU_LARGE": {
+			MachineType:  types.StringNull(),
+			Architecture: types.StringNull(),
+			Vcpu:         types.Int64Null(),
+			Memory:       types.Int64Null(),
+		},
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected.Name = types.StringValue(name)
+			expected.Description = types.StringNull()
+			if actual := newHostedAgentInstanceShape(name, nil); actual != expected {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckInstanceShape(t *testing.T) {
+	server, client := fakeTestClient(t)
+	server.AddInstanceShape("LINUX_AMD64_32X128", "Linux 32 vCPU x 128 GB Memory")
+	server.AddInstanceShape("GPU_LARGE", "GPU accelerated")
+
+	shapes := hostedAgentInstanceShapes(context.Background(), client)
+	// only the shapes known to the provider are used when there is no client
+	builtIn := hostedAgentInstanceShapes(context.Background(), nil)
+
+	testCases := map[string]struct {
+		shapes   []hostedAgentInstanceShapeModel
+		shape    string
+		mac      bool
+		linux    bool
+		expected string
+	}{
+		"accepts a mac shape":                          {shapes: shapes, shape: MacInstanceSmall, mac: true},
+		"accepts a linux shape":                        {shapes: shapes, shape: LinuxARM64InstanceLarge, linux: true},
+		"accepts a shape without a platform":           {shapes: shapes, shape: LinuxAMD64InstanceSmall},
+		"accepts a shape new to the catalog":           {shapes: shapes, shape: "LINUX_AMD64_32X128", linux: true},
+		"rejects a new shape without the catalog":      {shapes: builtIn, shape: "LINUX_AMD64_32X128", linux: true, expected: "Invalid instance shape for Linux platform"},
+		"rejects a linux shape for mac":                {shapes: shapes, shape: LinuxARM64InstanceSmall, mac: true, expected: "Invalid instance shape for Mac platform"},
+		"rejects a mac shape for linux":                {shapes: shapes, shape: MacInstanceSmall, linux: true, expected: "Invalid instance shape for Linux platform"},
+		"rejects a shape not in the catalog":           {shapes: shapes, shape: "WINDOWS_AMD64_2X4", expected: "Unsupported instance shape"},
+		"rejects a typo in the shape":                  {shapes: shapes, shape: "LINUX_AMD64_2x4", linux: true, expected: "Invalid instance shape for Linux platform"},
+		"accepts a catalog shape named unlike others":  {shapes: shapes, shape: "GPU_LARGE", linux: true},
+		"rejects an unusual shape without the catalog": {shapes: builtIn, shape: "GPU_LARGE", expected: "Unsupported instance shape"},
+		"accepts a built in shape without a platform":  {shapes: builtIn, shape: MacInstanceXLarge},
+	}
+
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := checkInstanceShape(c.shapes, c.shape, c.mac, c.linux)
+			if c.expected == "" {
+				if diags.HasError() {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary() != c.expected {
+				t.Errorf("expected the error %q, got %v", c.expected, diags)
+			}
+		})
+	}
+}
+
+func TestInstanceShapeValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		shape    types.String
+		errors   int
+		warnings int
+	}{
+		"known shape":                    {shape: types.StringValue(LinuxARM64InstanceMedium)},
+		"unknown value":                  {shape: types.StringUnknown()},
+		"shape added since the provider": {shape: types.StringValue("MACOS_M5_24X96"), warnings: 1},
+		"typo in the shape":              {shape: types.StringValue("LINUX_AMD64_2x4"), warnings: 1},
+		"shape named unlike the others":  {shape: types.StringValue("t3.large"), warnings: 1},
+	}
+
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+			instanceShapeValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("hosted_agents").AtName("instance_shape"),
+				ConfigValue: c.shape,
+			}, resp)
+
+			if resp.Diagnostics.ErrorsCount() != c.errors || resp.Diagnostics.WarningsCount() != c.warnings {
+				t.Errorf("expected %d errors and %d warnings, got %v", c.errors, c.warnings, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline"
+	"github.com/buildkite/go-pipeline/warning"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type pipelineTriggerGraphDataSourceModel struct {
+	Pipelines []types.String                           `tfsdk:"pipelines"`
+	Edges     map[string]pipelineTriggerGraphEdgeModel `tfsdk:"edges"`
+}
+
+type pipelineTriggerGraphEdgeModel struct {
+	SourcePipelineID   types.String `tfsdk:"source_pipeline_id"`
+	SourcePipelineUUID types.String `tfsdk:"source_pipeline_uuid"`
+	SourcePipelineSlug types.String `tfsdk:"source_pipeline_slug"`
+	TargetPipelineID   types.String `tfsdk:"target_pipeline_id"`
+	TargetPipelineUUID types.String `tfsdk:"target_pipeline_uuid"`
+	TargetPipelineSlug types.String `tfsdk:"target_pipeline_slug"`
+}
+
+type pipelineTriggerGraphDataSource struct {
+	client *Client
+}
+
+func newPipelineTriggerGraphDataSource() datasource.DataSource {
+	return &pipelineTriggerGraphDataSource{}
+}
+
+func (g *pipelineTriggerGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	g.client = req.ProviderData.(*Client)
+}
+
+func (*pipelineTriggerGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_trigger_graph"
+}
+
+func (*pipelineTriggerGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Use this data source to find which pipelines trigger builds of other pipelines. The steps of each pipeline
+			are parsed in the same way as the Buildkite agent, and every trigger step, including those in groups, becomes
+			an edge from the pipeline to the pipeline it triggers.
+
+			The edges can be used with %s to manage the %s resources allowing each trigger.
+
+			Only the steps saved on each pipeline are parsed. Steps uploaded while a build runs, and trigger steps whose
+			target uses an environment variable, are not included.
+
+			More info in the Buildkite [documentation](https://buildkite.com/docs/pipelines/configure/step-types/trigger-step).
+		`, "`for_each`", "`buildkite_organization_rule`"),
+		Attributes: map[string]schema.Attribute{
+			"pipelines": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The slugs of the pipelines whose steps are parsed for trigger steps.",
+			},
+			"edges": schema.MapNestedAttribute{
+				Computed: true,
+				MarkdownDescription: heredoc.Docf(`
+					The pipelines triggered by each pipeline, keyed by the source and target pipeline slugs in the form
+					%s. Targets are included whether or not they are in %s.
+				`, "`source:target`", "`pipelines`"),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_pipeline_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The GraphQL ID of the pipeline with the trigger step.",
+						},
+						"source_pipeline_uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the pipeline with the trigger step.",
+						},
+						"source_pipeline_slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The slug of the pipeline with the trigger step.",
+						},
+						"target_pipeline_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The GraphQL ID of the triggered pipeline.",
+						},
+						"target_pipeline_uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the triggered pipeline.",
+						},
+						"target_pipeline_slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The slug of the triggered pipeline.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (g *pipelineTriggerGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pipelineTriggerGraphDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// pipelines are looked up once each, as many pipelines usually trigger the same few
+	pipelines := make(map[string]*getPipelinePipeline)
+	lookup := func(slug string) (*getPipelinePipeline, error) {
+		if p, ok := pipelines[slug]; ok {
+			return p, nil
+		}
+
+		orgPipelineSlug := fmt.Sprintf("%s/%s", g.client.organization, slug)
+		log.Printf("Obtaining pipeline with slug %s ...", orgPipelineSlug)
+		r, err := getPipeline(ctx, g.client.genqlient, orgPipelineSlug)
+		if err != nil && !isNotFoundError(err) {
+			return nil, err
+		}
+
+		var p *getPipelinePipeline
+		if r != nil && r.Pipeline.Id != "" {
+			p = &r.Pipeline
+		}
+		pipelines[slug] = p
+		return p, nil
+	}
+
+	state.Edges = make(map[string]pipelineTriggerGraphEdgeModel)
+	for _, slug := range state.Pipelines {
+		source, err := lookup(slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read pipeline",
+				fmt.Sprintf("Unable to read pipeline %s: %s", slug.ValueString(), describeError(err)),
+			)
+			return
+		}
+		if source == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pipelines"),
+				"Unable to find pipeline",
+				fmt.Sprintf("Could not find pipeline with slug \"%s\"", slug.ValueString()),
+			)
+			return
+		}
+
+		targets, err := pipelineTriggerTargets(source.Steps.Yaml)
+		if w := warning.As(err); w != nil {
+			resp.Diagnostics.AddWarning(
+				"Problem with pipeline steps",
+				fmt.Sprintf("The steps of pipeline %s have problems, so some of its triggers may not be included: %s", source.Slug, w.Error()),
+			)
+		} else if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to parse pipeline steps",
+				fmt.Sprintf("The steps of pipeline %s could not be parsed and its triggers are not included: %s", source.Slug, err.Error()),
+			)
+			continue
+		}
+
+		for _, targetSlug := range targets {
+			if strings.Contains(targetSlug, "$") {
+				resp.Diagnostics.AddWarning(
+					"Unable to resolve trigger target",
+					fmt.Sprintf("Pipeline %s triggers %q, which depends on the environment of the build and is not included.", source.Slug, targetSlug),
+				)
+				continue
+			}
+
+			target, err := lookup(targetSlug)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to read pipeline",
+					fmt.Sprintf("Unable to read pipeline %s: %s", targetSlug, describeError(err)),
+				)
+				return
+			}
+			if target == nil {
+				resp.Diagnostics.AddWarning(
+					"Unable to resolve trigger target",
+					fmt.Sprintf("Pipeline %s triggers %s, which could not be found and is not included.", source.Slug, targetSlug),
+				)
+				continue
+			}
+
+			state.Edges[fmt.Sprintf("%s:%s", source.Slug, target.Slug)] = pipelineTriggerGraphEdgeModel{
+				SourcePipelineID:   types.StringValue(source.Id),
+				SourcePipelineUUID: types.StringValue(source.PipelineUuid),
+				SourcePipelineSlug: types.StringValue(source.Slug),
+				TargetPipelineID:   types.StringValue(target.Id),
+				TargetPipelineUUID: types.StringValue(target.PipelineUuid),
+				TargetPipelineSlug: types.StringValue(target.Slug),
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// pipelineTriggerTargets returns the sorted, distinct slugs of the pipelines triggered by the steps of a pipeline.
+// When the parser only warns about the steps, the targets are returned along with the warning.
+func pipelineTriggerTargets(steps string) ([]string, error) {
+	p, err := pipeline.Parse(strings.NewReader(steps))
+	if err != nil && warning.As(err) == nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	collectTriggerTargets(p.Steps, seen)
+
+	targets := make([]string, 0, len(seen))
+	for target := range seen {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+	return targets, err
+}
+
+func collectTriggerTargets(steps pipeline.Steps, seen map[string]bool) {
+	for _, step := range steps {
+		switch step := step.(type) {
+		case *pipeline.TriggerStep:
+			if target, ok := step.Contents["trigger"].(string); ok && target != "" {
+				seen[target] = true
+			}
+		case *pipeline.GroupStep:
+			collectTriggerTargets(step.Steps, seen)
+		}
+	}
+}
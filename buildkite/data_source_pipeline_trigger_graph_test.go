@@ -0,0 +1,141 @@
+package buildkite
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline/warning"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPipelineTriggerTargets(t *testing.T) {
+	testCases := map[string]struct {
+		steps    string
+		expected []string
+		err      bool
+		warning  bool
+	}{
+		"no triggers": {
+			steps: "steps:\n  - command: make test\n",
+		},
+		"triggers in groups are included once": {
+			steps: heredoc.Doc(`
+				steps:
+				  - trigger: deploy
+				  - wait
+				  - group: release
+				    steps:
+				      - trigger: deploy
+				        build:
+				          branch: main
+				      - trigger: announce
+			`),
+			expected: []string{"announce", "deploy"},
+		},
+		"bare sequence of steps": {
+			steps:    "- trigger: deploy\n- command: make test\n",
+			expected: []string{"deploy"},
+		},
+		"steps with warnings are still collected": {
+			steps: heredoc.Doc(`
+				steps:
+				  - trigger: deploy
+				  - type: comand
+				    comand: make lint
+			`),
+			expected: []string{"deploy"},
+			warning:  true,
+		},
+		"invalid steps": {
+			steps: "steps: [",
+			err:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			targets, err := pipelineTriggerTargets(testCase.steps)
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if testCase.warning {
+				if warning.As(err) == nil {
+					t.Fatalf("expected a warning, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(targets, ",") != strings.Join(testCase.expected, ",") {
+				t.Errorf("expected targets %v, got %v", testCase.expected, targets)
+			}
+		})
+	}
+}
+
+func TestAccBuildkitePipelineTriggerGraphDataSource(t *testing.T) {
+	t.Run("pipeline trigger graph can be used to create organization rules", func(t *testing.T) {
+		sourceName := acctest.RandString(12)
+		targetName := acctest.RandString(12)
+		pipelines := fmt.Sprintf(`
+			resource "buildkite_pipeline" "target" {
+				name       = "%s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+			}
+
+			resource "buildkite_pipeline" "source" {
+				name       = "%s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+				steps      = yamlencode({
+					steps = [
+						{ command = "make test" },
+						{ group = "deploy", steps = [
+							{ trigger = buildkite_pipeline.target.slug },
+							{ trigger = "$${DEPLOY_TARGET}" },
+						] },
+						{ trigger = "missing-pipeline-%s" },
+					]
+				})
+			}
+		`, targetName, sourceName, targetName)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckOrganizationRuleDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: pipelines,
+				},
+				{
+					Config: pipelines + fmt.Sprintf(`
+						data "buildkite_pipeline_trigger_graph" "graph" {
+							pipelines = [buildkite_pipeline.source.slug]
+						}
+
+						resource "buildkite_organization_rule" "trigger" {
+							pipeline_trigger_build = {
+								source_pipeline_id = data.buildkite_pipeline_trigger_graph.graph.edges["%s:%s"].source_pipeline_id
+								target_pipeline_id = data.buildkite_pipeline_trigger_graph.graph.edges["%s:%s"].target_pipeline_id
+							}
+						}
+					`, sourceName, targetName, sourceName, targetName),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.buildkite_pipeline_trigger_graph.graph", "edges.%", "1"),
+						resource.TestCheckResourceAttrPair(
+							"data.buildkite_pipeline_trigger_graph.graph", fmt.Sprintf("edges.%s:%s.target_pipeline_uuid", sourceName, targetName),
+							"buildkite_pipeline.target", "uuid",
+						),
+						resource.TestCheckResourceAttrPair("buildkite_organization_rule.trigger", "source_uuid", "buildkite_pipeline.source", "uuid"),
+						resource.TestCheckResourceAttrPair("buildkite_organization_rule.trigger", "target_uuid", "buildkite_pipeline.target", "uuid"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -4,30 +4,32 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/buildkite/go-pipeline"
-	"github.com/buildkite/go-pipeline/signature"
-	"github.com/buildkite/interpolate"
 	"github.com/buildkite/terraform-provider-buildkite/internal/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/lestrrat-go/jwx/v2/jwk"
-	"gopkg.in/yaml.v3"
 )
 
 type signedPipelineStepsDataSource struct {
-	UnsignedSteps types.String `tfsdk:"unsigned_steps"`
-	Repository    types.String `tfsdk:"repository"`
-	JWKS          types.String `tfsdk:"jwks"`
-	JWKSFile      types.String `tfsdk:"jwks_file"`
-	JWKSKeyID     types.String `tfsdk:"jwks_key_id"`
-	Steps         types.String `tfsdk:"steps"`
+	UnsignedSteps      types.String `tfsdk:"unsigned_steps"`
+	Repository         types.String `tfsdk:"repository"`
+	JWKS               types.String `tfsdk:"jwks"`
+	JWKSFile           types.String `tfsdk:"jwks_file"`
+	JWKSKeyID          types.String `tfsdk:"jwks_key_id"`
+	JWKSEnv            types.String `tfsdk:"jwks_env"`
+	PrivateKeyPEM      types.String `tfsdk:"private_key_pem"`
+	PrivateKeyFile     types.String `tfsdk:"private_key_file"`
+	PrivateKeyPassword types.String `tfsdk:"private_key_password"`
+	Algorithm          types.String `tfsdk:"algorithm"`
+	Env                types.Map    `tfsdk:"env"`
+	Interpolation      types.String `tfsdk:"interpolation"`
+	Steps              types.String `tfsdk:"steps"`
 }
 
 func newSignedPipelineStepsDataSource() datasource.DataSource {
@@ -96,7 +98,13 @@ func (s *signedPipelineStepsDataSource) Schema(
 				),
 				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRoot("jwks"), path.MatchRoot("jwks_file")),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("jwks"),
+						path.MatchRoot("jwks_file"),
+						path.MatchRoot("jwks_env"),
+						path.MatchRoot("private_key_pem"),
+						path.MatchRoot("private_key_file"),
+					),
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
@@ -125,6 +133,84 @@ func (s *signedPipelineStepsDataSource) Schema(
 					&datasourcevalidator.JWKSValidator{},
 				},
 			},
+			"jwks_env": schema.StringAttribute{
+				MarkdownDescription: heredoc.Doc(
+					`
+						The name of an environment variable on the system running Terraform that contains
+						the JSON Web Key Set (JWKS) to use for signing, such as a secret injected by CI.
+						The key is never stored in the Terraform state.
+					`,
+				),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						A private key in PEM format to use for signing, as a PKCS#8, SEC1 (EC) or PKCS#1
+						(RSA) key. The signature algorithm is inferred from the key unless %s is set,
+						and the key ID is the key's RFC 7638 thumbprint unless %s is set.
+
+						~> **Security Notice** The private key in the %s attribute will be stored
+						*unencrypted* in your Terraform state file. If you wish to avoid this, use the
+						%s attribute instead.
+					`,
+					"`algorithm`",
+					"`jwks_key_id`",
+					"`private_key_pem`",
+					"`private_key_file`",
+				),
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					&datasourcevalidator.PrivateKeyPEMValidator{},
+				},
+			},
+			"private_key_file": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						The path to a file containing a private key in PEM format to use for signing. The
+						same formats as %s are supported.
+					`,
+					"`private_key_pem`",
+				),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"private_key_password": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						The password of an encrypted private key. Both PKCS#8 keys encrypted with PBES2
+						(%s) and legacy encrypted PEM keys are supported.
+					`,
+					"`openssl pkcs8 -topk8 -v2 aes-256-cbc`",
+				),
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("jwks"), path.MatchRoot("jwks_file"), path.MatchRoot("jwks_env")),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						The signature algorithm to use with a PEM private key. Ed25519 and EC keys only
+						support one algorithm, which is inferred. RSA keys default to %s and also support %s,
+						whose signatures change each time the steps are signed.
+					`,
+					"`RS256`",
+					"`PS256`",
+				),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(pipelineSigningAlgorithms...),
+					stringvalidator.ConflictsWith(path.MatchRoot("jwks"), path.MatchRoot("jwks_file"), path.MatchRoot("jwks_env")),
+				},
+			},
 			"jwks_key_id": schema.StringAttribute{
 				Description: "The ID of the key in the JSON Web Key Set (JWKS) to use for signing. If this is not specified, and the JWKS contains exactly one key, that key will be used.",
 				MarkdownDescription: heredoc.Doc(
@@ -136,6 +222,46 @@ func (s *signedPipelineStepsDataSource) Schema(
 				),
 				Optional: true,
 			},
+			"env": schema.MapAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						Values for environment variables to substitute into the steps before they are
+						signed, used with the %s and %s interpolation modes. Variables in the
+						env block of the steps are also substituted.
+					`,
+					"`resolve_with_env`",
+					"`preserve_runtime`",
+				),
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"interpolation": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						How environment interpolations (eg %s) in %s are handled. Defaults to %s.
+
+						- %s fails if the steps contain any environment interpolations.
+						- %s substitutes every interpolation with a value from %s or the
+						  env block of the steps, in the same way the agent does when uploading a pipeline,
+						  and fails if any has no value.
+						- %s substitutes the interpolations that have a value, and leaves the
+						  others unchanged in the signed steps, so variables only known when the job runs,
+						  like %s, are expanded by the shell and still pass verification.
+					`,
+					"`$SOME_VARIABLE`",
+					"`unsigned_steps`",
+					"`reject`",
+					"`reject`",
+					"`resolve_with_env`",
+					"`env`",
+					"`preserve_runtime`",
+					"`$BUILDKITE_BRANCH`",
+				),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(interpolationModes...),
+				},
+			},
 			"steps": schema.StringAttribute{
 				Description: "The signed steps in YAML format.",
 				Computed:    true,
@@ -155,83 +281,88 @@ func (s *signedPipelineStepsDataSource) Read(
 		return
 	}
 
-	unsignedSteps := data.UnsignedSteps.ValueString()
-	expansions, err := interpolate.Identifiers(unsignedSteps)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to discover environment expansions in unsigned steps", err.Error())
+	jwksContents, diags := data.signingJWKS(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if len(expansions) > 0 {
-		for i, e := range expansions {
-			// in interpolate, the identifiers of expansions don't have the $ prefix, and escaped expansions only have one
-			expansions[i] = "$" + e
-		}
-
-		err = fmt.Errorf("pipeline contains environment interpolations, which are only supported when dynamically "+
-			"uploading a pipeline, and not when statically signing pipelines using this tool. "+
-			"Note that terraform interpolations (eg `${var.some_variable}`) are fully supported, but environment interpolations "+
-			"(eg `$SOME_VARIABLE`) are not. "+
-			"Please remove the following interpolation directives from the `unsigned_steps` input: %s", strings.Join(expansions, ", "))
-		resp.Diagnostics.AddError("Environment interpolations are not allowed", err.Error())
+	interpolation := stepsInterpolation{Mode: data.Interpolation.ValueString()}
+	resp.Diagnostics.Append(data.Env.ElementsAs(ctx, &interpolation.Env, false)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	p, err := pipeline.Parse(strings.NewReader(unsignedSteps))
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to parse pipeline steps", err.Error())
+	signedSteps, diags := signPipelineSteps(ctx, data.UnsignedSteps.ValueString(), "unsigned_steps", data.Repository.ValueString(), jwksContents, data.JWKSKeyID, interpolation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// validators ensure that only one of `jwks` or `jwks_file` is set
-	jwksContents := []byte(data.JWKS.ValueString())
-	if len(jwksContents) == 0 {
-		jwksContents, err = os.ReadFile(data.JWKSFile.ValueString())
+	data.Steps = types.StringValue(signedSteps)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// signingJWKS returns the JWKS to sign with from whichever key source is configured. Validators ensure exactly one is.
+func (s *signedPipelineStepsDataSource) signingJWKS(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !s.JWKS.IsNull():
+		return []byte(s.JWKS.ValueString()), diags
+
+	case !s.JWKSFile.IsNull():
+		contents, err := os.ReadFile(s.JWKSFile.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read JWKS file", err.Error())
-			return
+			diags.AddError("Unable to read JWKS file", err.Error())
 		}
-	}
-
-	jwks, err := jwk.Parse(jwksContents)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to parse JWKS", err.Error())
-		return
-	}
+		return contents, diags
 
-	var key jwk.Key
-	if data.JWKSKeyID.IsNull() {
-		if jwks.Len() != 1 {
-			resp.Diagnostics.AddError(
-				"Cannot find key",
-				"JWKS does not contain exactly one key, but no key ID was specified",
+	case !s.JWKSEnv.IsNull():
+		contents, ok := os.LookupEnv(s.JWKSEnv.ValueString())
+		if !ok || contents == "" {
+			diags.AddError(
+				"Unable to read JWKS from the environment",
+				fmt.Sprintf("The environment variable %q is not set", s.JWKSEnv.ValueString()),
 			)
-			return
+			return nil, diags
 		}
-		key, _ = jwks.Key(0)
-	} else {
-		ok := false
-		keyID := data.JWKSKeyID.ValueString()
-		if key, ok = jwks.LookupKeyID(keyID); !ok {
-			resp.Diagnostics.AddError(
-				"Cannot find key",
-				fmt.Sprintf("The key with ID %q was not found in the JWKS", keyID),
-			)
-			return
+
+		// the contents are validated the same way as the jwks attribute, to avoid printing them in errors
+		validation := &validator.StringResponse{}
+		(&datasourcevalidator.JWKSValidator{}).ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("jwks_env"),
+			ConfigValue: types.StringValue(contents),
+		}, validation)
+		diags.Append(validation.Diagnostics...)
+		return []byte(contents), diags
+	}
+
+	contents := []byte(s.PrivateKeyPEM.ValueString())
+	if !s.PrivateKeyFile.IsNull() {
+		var err error
+		contents, err = os.ReadFile(s.PrivateKeyFile.ValueString())
+		if err != nil {
+			diags.AddError("Unable to read private key file", err.Error())
+			return nil, diags
 		}
 	}
 
-	if err := signature.SignSteps(ctx, p.Steps, key, data.Repository.ValueString(), signature.WithEnv(p.Env.ToMap())); err != nil {
-		resp.Diagnostics.AddError("Failed to sign pipeline", err.Error())
-		return
+	key, err := parsePrivateKeyPEM(contents, s.PrivateKeyPassword.ValueString())
+	if err != nil {
+		diags.AddError("Unable to parse private key", err.Error())
+		return nil, diags
 	}
 
-	signedSteps, err := yaml.Marshal(p)
+	alg, err := inferSigningAlgorithm(key, s.Algorithm.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to marshal pipeline", err.Error())
-		return
+		diags.AddAttributeError(path.Root("algorithm"), "Unsupported signing algorithm", err.Error())
+		return nil, diags
 	}
 
-	data.Steps = types.StringValue(string(signedSteps))
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	privateJWKS, _, err := newSigningKeyPair(key, alg, s.JWKSKeyID.ValueString())
+	if err != nil {
+		diags.AddError("Unable to create JWKS from private key", err.Error())
+	}
+	return privateJWKS, diags
 }
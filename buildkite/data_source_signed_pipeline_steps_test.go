@@ -2,7 +2,9 @@ package buildkite
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"os"
 	"regexp"
 	"strings"
@@ -126,6 +128,75 @@ func TestAccBuildkiteSignedPipelineStepsDataSource(t *testing.T) {
 		})
 	})
 
+	t.Run("signed pipeline steps with preserved runtime interpolations signs the steps", func(t *testing.T) {
+		pipelineWithInterpolations := heredoc.Doc(`
+			steps:
+			- label: ":pipeline:"
+			  command: 'deploy --image $IMAGE --branch $BUILDKITE_BRANCH'
+		`)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							data "buildkite_signed_pipeline_steps" "my_signed_steps" {
+							  repository     = %q
+							  jwks           = %q
+							  jwks_key_id    = %q
+							  unsigned_steps = %q
+							  interpolation  = "preserve_runtime"
+							  env = {
+							    IMAGE = "app:1.2.3"
+							  }
+							}
+						`,
+						repository,
+						jwks,
+						jwksKeyID,
+						pipelineWithInterpolations,
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr(
+							"data.buildkite_signed_pipeline_steps.my_signed_steps",
+							"steps",
+							regexp.MustCompile(regexp.QuoteMeta("deploy --image app:1.2.3 --branch $BUILDKITE_BRANCH")),
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("signed pipeline steps with unresolved interpolations fails", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							data "buildkite_signed_pipeline_steps" "my_signed_steps" {
+							  repository     = %q
+							  jwks           = %q
+							  jwks_key_id    = %q
+							  unsigned_steps = %q
+							  interpolation  = "resolve_with_env"
+							}
+						`,
+						repository,
+						jwks,
+						jwksKeyID,
+						"steps:\n- command: echo $BUILDKITE_BRANCH\n",
+					),
+					ExpectError: regexp.MustCompile("Environment interpolations without a value"),
+				},
+			},
+		})
+	})
+
 	t.Run("signed pipeline steps with escaped interpolations regex", func(t *testing.T) {
 		pipelineWithEscapedInterpolations := heredoc.Doc(`
 			steps:
@@ -198,6 +269,107 @@ func TestAccBuildkiteSignedPipelineStepsDataSource(t *testing.T) {
 		})
 	})
 
+	t.Run("signed pipeline steps with a jwks_env attribute signs the steps", func(t *testing.T) {
+		t.Setenv("TEST_SIGNING_JWKS", string(jwks))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							data "buildkite_signed_pipeline_steps" "my_signed_steps" {
+							  repository     = %q
+							  jwks_env       = "TEST_SIGNING_JWKS"
+							  jwks_key_id    = %q
+							  unsigned_steps = %q
+							}
+						`,
+						repository,
+						jwksKeyID,
+						steps,
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.buildkite_signed_pipeline_steps.my_signed_steps",
+							"steps",
+							string(signedSteps),
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("signed pipeline steps with a private_key_file attribute signs the steps", func(t *testing.T) {
+		var raw any
+		if err := privateKey.Raw(&raw); err != nil {
+			t.Fatalf("Failed to get raw private key: %v", err)
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(raw)
+		if err != nil {
+			t.Fatalf("Failed to marshal private key: %v", err)
+		}
+		keyFile := writeToTempFile(t, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							data "buildkite_signed_pipeline_steps" "my_signed_steps" {
+							  repository       = %q
+							  private_key_file = %q
+							  jwks_key_id      = %q
+							  unsigned_steps   = %q
+							}
+						`,
+						repository,
+						keyFile,
+						jwksKeyID,
+						steps,
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						// EdDSA signatures are deterministic, so a key from PEM signs identically to the JWKS
+						resource.TestCheckResourceAttr(
+							"data.buildkite_signed_pipeline_steps.my_signed_steps",
+							"steps",
+							string(signedSteps),
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("signed pipeline steps with an encrypted private_key_pem and a wrong password fails", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							data "buildkite_signed_pipeline_steps" "my_signed_steps" {
+							  repository           = %q
+							  private_key_pem      = %q
+							  private_key_password = "wrong"
+							  unsigned_steps       = %q
+							}
+						`,
+						repository,
+						encryptedPKCS8Key,
+						steps,
+					),
+					ExpectError: regexp.MustCompile("incorrect password"),
+				},
+			},
+		})
+	})
+
 	t.Run("signed pipeline steps with a jwks_file and a jwks fails validation", func(t *testing.T) {
 		jwksFile := writeToTempFile(t, jwks)
 
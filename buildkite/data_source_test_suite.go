@@ -26,12 +26,13 @@ func newTestSuiteDatasource() datasource.DataSource {
 }
 
 // Configure implements datasource.DataSourceWithConfigure.
-func (t *testSuiteDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (t *testSuiteDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	t.client = req.ProviderData.(*Client)
+	t.client.warnMissingScopes("data.buildkite_test_suite", []string{"read_suites"}, &resp.Diagnostics)
 }
 
 // Metadata implements datasource.DataSource.
@@ -54,7 +55,7 @@ func (t *testSuiteDatasource) Read(ctx context.Context, req datasource.ReadReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to read test suite",
-			fmt.Sprintf("Failed to read test suite %s", err.Error()),
+			fmt.Sprintf("Failed to read test suite: %s", t.client.describeScopedError(err, "read_suites")),
 		)
 		return
 	}
@@ -0,0 +1,159 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tokenDatasourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UUID             types.String `tfsdk:"uuid"`
+	Description      types.String `tfsdk:"description"`
+	Scopes           types.List   `tfsdk:"scopes"`
+	UserID           types.String `tfsdk:"user_id"`
+	UserUUID         types.String `tfsdk:"user_uuid"`
+	UserName         types.String `tfsdk:"user_name"`
+	UserEmail        types.String `tfsdk:"user_email"`
+	Organization     types.String `tfsdk:"organization"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+}
+
+type tokenDatasource struct {
+	client *Client
+}
+
+func newTokenDatasource() datasource.DataSource {
+	return &tokenDatasource{}
+}
+
+func (t *tokenDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	t.client = req.ProviderData.(*Client)
+}
+
+func (*tokenDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (t *tokenDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tokenDatasourceModel
+	var token AccessTokenResponse
+
+	err := t.client.makeRequest(ctx, "GET", "/v2/access-token", nil, &token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read API token",
+			fmt.Sprintf("Failed to read API token %s", err.Error()),
+		)
+		return
+	}
+
+	viewer, err := getViewer(ctx, t.client.genqlient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read API token user",
+			fmt.Sprintf("Failed to read API token user %s", err.Error()),
+		)
+		return
+	}
+
+	org, err := getOrganization(ctx, t.client.genqlient, t.client.organization)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read organization",
+			fmt.Sprintf("Failed to read organization %s", err.Error()),
+		)
+		return
+	}
+
+	// a consistent order will ensure a change in ordering from the server won't trigger changes in a terraform plan
+	sort.Strings(token.Scopes)
+
+	scopes, diag := types.ListValueFrom(ctx, types.StringType, token.Scopes)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	state.ID = types.StringValue(token.UUID)
+	state.UUID = types.StringValue(token.UUID)
+	state.Description = types.StringValue(token.Description)
+	state.Scopes = scopes
+	state.UserID = types.StringValue(viewer.Viewer.User.Id)
+	state.UserUUID = types.StringValue(viewer.Viewer.User.Uuid)
+	state.UserName = types.StringValue(viewer.Viewer.User.Name)
+	state.UserEmail = types.StringValue(viewer.Viewer.User.Email)
+	state.Organization = types.StringValue(t.client.organization)
+	state.OrganizationID = types.StringValue(org.Organization.Id)
+	state.OrganizationUUID = types.StringValue(org.Organization.Uuid)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (*tokenDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Use this data source to look up details of the API token the provider is configured with, including its
+			scopes and the user it belongs to.
+
+			More info in the Buildkite [documentation](https://buildkite.com/docs/apis/rest-api/access-token).
+		`),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the API token.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the API token.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the API token.",
+			},
+			"scopes": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The REST API scopes granted to the API token.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the user the API token belongs to.",
+			},
+			"user_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the user the API token belongs to.",
+			},
+			"user_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the user the API token belongs to.",
+			},
+			"user_email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address of the user the API token belongs to.",
+			},
+			"organization": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug of the organization the provider is configured with.",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the organization the provider is configured with.",
+			},
+			"organization_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the organization the provider is configured with.",
+			},
+		},
+	}
+}
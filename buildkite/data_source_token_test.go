@@ -0,0 +1,78 @@
+package buildkite
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBuildkiteTokenDatasource(t *testing.T) {
+	config := `
+		data "buildkite_token" "current" {
+		}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.buildkite_token.current", "uuid"),
+					resource.TestCheckResourceAttrSet("data.buildkite_token.current", "user_id"),
+					resource.TestCheckResourceAttrSet("data.buildkite_token.current", "user_email"),
+					resource.TestCheckResourceAttrSet("data.buildkite_token.current", "organization_id"),
+					resource.TestCheckResourceAttr("data.buildkite_token.current", "organization", getenv("BUILDKITE_ORGANIZATION_SLUG")),
+					resource.TestCheckTypeSetElemAttr("data.buildkite_token.current", "scopes.*", "graphql"),
+				),
+			},
+		},
+	})
+}
+
+func TestWarnMissingScopes(t *testing.T) {
+	t.Run("unvalidated token does not warn", func(t *testing.T) {
+		client := &Client{scopeWarnings: &sync.Map{}}
+		var diags diag.Diagnostics
+
+		client.warnMissingScopes("buildkite_pipeline", []string{"write_pipelines"}, &diags)
+		if diags.WarningsCount() != 0 {
+			t.Fatalf("expected no warnings, got %d", diags.WarningsCount())
+		}
+	})
+
+	t.Run("missing scopes warn once per type", func(t *testing.T) {
+		client := &Client{
+			tokenScopes:   []string{"graphql", "read_pipelines"},
+			scopeWarnings: &sync.Map{},
+		}
+		var diags diag.Diagnostics
+
+		client.warnMissingScopes("buildkite_pipeline", []string{"read_pipelines", "write_pipelines"}, &diags)
+		client.warnMissingScopes("buildkite_pipeline", []string{"read_pipelines", "write_pipelines"}, &diags)
+		if diags.WarningsCount() != 1 {
+			t.Fatalf("expected 1 warning, got %d", diags.WarningsCount())
+		}
+
+		client.warnMissingScopes("buildkite_test_suite", []string{"write_suites"}, &diags)
+		if diags.WarningsCount() != 2 {
+			t.Fatalf("expected 2 warnings, got %d", diags.WarningsCount())
+		}
+	})
+
+	t.Run("granted scopes do not warn", func(t *testing.T) {
+		client := &Client{
+			tokenScopes:   []string{"graphql", "read_suites", "write_suites"},
+			scopeWarnings: &sync.Map{},
+		}
+		var diags diag.Diagnostics
+
+		client.warnMissingScopes("buildkite_test_suite", []string{"read_suites", "write_suites"}, &diags)
+		if diags.WarningsCount() != 0 {
+			t.Fatalf("expected no warnings, got %d", diags.WarningsCount())
+		}
+	})
+}
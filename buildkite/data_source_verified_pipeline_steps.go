@@ -0,0 +1,188 @@
+package buildkite
+
+import (
+	"context"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/terraform-provider-buildkite/internal/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type verifiedPipelineStepsDataSourceModel struct {
+	Steps      types.String                  `tfsdk:"steps"`
+	Repository types.String                  `tfsdk:"repository"`
+	JWKS       types.String                  `tfsdk:"jwks"`
+	JWKSFile   types.String                  `tfsdk:"jwks_file"`
+	Verified   types.Bool                    `tfsdk:"verified"`
+	Results    []verifiedPipelineStepsResult `tfsdk:"results"`
+}
+
+type verifiedPipelineStepsResult struct {
+	Path     types.String `tfsdk:"path"`
+	Key      types.String `tfsdk:"key"`
+	Label    types.String `tfsdk:"label"`
+	Verified types.Bool   `tfsdk:"verified"`
+	Error    types.String `tfsdk:"error"`
+}
+
+type verifiedPipelineStepsDataSource struct{}
+
+func newVerifiedPipelineStepsDataSource() datasource.DataSource {
+	return &verifiedPipelineStepsDataSource{}
+}
+
+func (v *verifiedPipelineStepsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_verified_pipeline_steps"
+}
+
+func (v *verifiedPipelineStepsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "A data source that verifies the signatures of signed pipeline steps with a public JWKS",
+		MarkdownDescription: heredoc.Docf(
+			`
+				Use this data source to verify the signatures of pipeline steps signed with the
+				%s data source, or by any other means, against a public JSON Web Key Set (JWKS).
+				Every command step is verified in the same way as the Buildkite agent would before
+				running it, and the result for each step is reported.
+
+				Pair this with a %s to catch steps that were edited by hand, or that were
+				signed with a key that has since been rotated out, at plan time.
+
+				See the Buildkite [documentation](https://buildkite.com/docs/agent/v3/signed_pipelines)
+				for more info about signed pipelines.
+			`,
+			"`buildkite_signed_pipeline_steps`",
+			"`postcondition`",
+		),
+		Attributes: map[string]schema.Attribute{
+			"steps": schema.StringAttribute{
+				Description: "The signed steps in YAML format.",
+				Required:    true,
+			},
+			"repository": schema.StringAttribute{
+				Description: "The repository that will be checked out in a build of the pipeline.",
+				Required:    true,
+			},
+			"jwks": schema.StringAttribute{
+				MarkdownDescription: heredoc.Docf(
+					`
+						The public JSON Web Key Set (JWKS) to verify the signatures with. Verification
+						uses the key with the ID in each signature, so the set may contain several keys
+						during a key rotation. Exactly one of %s or %s must be set.
+					`,
+					"`jwks`",
+					"`jwks_file`",
+				),
+				Optional: true,
+				Validators: []validator.String{
+					&datasourcevalidator.JWKSValidator{},
+				},
+			},
+			"jwks_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a local file containing the public JSON Web Key Set (JWKS) to verify the signatures with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("jwks"), path.MatchRoot("jwks_file")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether every command step has a valid signature. This is false if the steps have no command steps.",
+				Computed:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of verifying each command step, including those within group steps.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The location of the step in the pipeline, for example `steps[1].steps[0]` for the first step of the second group.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the step, if it has one.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the step, if it has one.",
+							Computed:            true,
+						},
+						"verified": schema.BoolAttribute{
+							MarkdownDescription: "Whether the step has a valid signature.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The reason the step failed verification.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *verifiedPipelineStepsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data verifiedPipelineStepsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validators ensure that only one of `jwks` or `jwks_file` is set
+	jwksContents := []byte(data.JWKS.ValueString())
+	if len(jwksContents) == 0 {
+		var err error
+		jwksContents, err = os.ReadFile(data.JWKSFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read JWKS file", err.Error())
+			return
+		}
+	}
+
+	results, diags := verifyPipelineSteps(ctx, data.Steps.ValueString(), data.Repository.ValueString(), jwksContents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Verified, data.Results = newVerifiedPipelineStepsResults(results)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newVerifiedPipelineStepsResults converts step verifications to their Terraform values, along with whether every
+// step was verified. Steps without any command steps are not verified, as there is no signature to check.
+func newVerifiedPipelineStepsResults(results []stepVerification) (types.Bool, []verifiedPipelineStepsResult) {
+	verified := len(results) > 0
+	values := make([]verifiedPipelineStepsResult, 0, len(results))
+	for _, result := range results {
+		verified = verified && result.Verified
+		values = append(values, verifiedPipelineStepsResult{
+			Path:     types.StringValue(result.Path),
+			Key:      stringValueOrNull(result.Key),
+			Label:    stringValueOrNull(result.Label),
+			Verified: types.BoolValue(result.Verified),
+			Error:    stringValueOrNull(result.Error),
+		})
+	}
+	return types.BoolValue(verified), values
+}
@@ -0,0 +1,139 @@
+package buildkite
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline/jwkutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+func TestAccBuildkiteVerifiedPipelineStepsDataSource(t *testing.T) {
+	const repository = "my-repo"
+
+	privateJWKS, publicJWKS, err := jwkutil.NewKeyPair("my-key-id", jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	privateKeys, err := json.Marshal(privateJWKS)
+	if err != nil {
+		t.Fatalf("Failed to marshal private JWKS: %v", err)
+	}
+	publicKeys, err := json.Marshal(publicJWKS)
+	if err != nil {
+		t.Fatalf("Failed to marshal public JWKS: %v", err)
+	}
+
+	signedSteps, diags := signPipelineSteps(
+		t.Context(),
+		heredoc.Doc(`
+			steps:
+			- label: ":pipeline:"
+			  key: upload
+			  command: buildkite-agent pipeline upload
+			- group: tests
+			  steps:
+			  - command: make test
+		`),
+		"unsigned_steps",
+		repository,
+		privateKeys,
+		types.StringNull(),
+		stepsInterpolation{},
+	)
+	if diags.HasError() {
+		t.Fatalf("Failed to sign steps: %v", diags)
+	}
+
+	config := func(steps string) string {
+		return heredoc.Docf(
+			`
+				data "buildkite_verified_pipeline_steps" "verified" {
+				  repository = %q
+				  jwks       = %q
+				  steps      = %q
+				}
+			`,
+			repository,
+			publicKeys,
+			steps,
+		)
+	}
+
+	t.Run("verified pipeline steps reports every signed command step as verified", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(signedSteps),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "true"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.#", "2"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.0.path", "steps[0]"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.0.key", "upload"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.0.label", ":pipeline:"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.1.path", "steps[1].steps[0]"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.1.verified", "true"),
+						resource.TestCheckNoResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.1.error"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("verified pipeline steps reports a step edited after signing", func(t *testing.T) {
+		editedSteps := strings.Replace(signedSteps, "make test", "make deploy", 1)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(editedSteps),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "false"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.0.verified", "true"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.1.verified", "false"),
+						resource.TestCheckResourceAttrSet("data.buildkite_verified_pipeline_steps.verified", "results.1.error"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("verified pipeline steps reports unsigned steps", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config("steps:\n- command: echo unsigned\n"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "false"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "results.0.error", "step is not signed"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("verified pipeline steps with invalid steps fails", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      config(fmt.Sprintf("steps: %q", "not a list")),
+					ExpectError: regexp.MustCompile("Unable to parse pipeline steps"),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,77 @@
+package buildkite
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// deletionPolicyDelete deletes the resource from Buildkite when it is destroyed
+	deletionPolicyDelete = "DELETE"
+	// deletionPolicyRetain only removes the resource from state when it is destroyed, leaving it in Buildkite
+	deletionPolicyRetain = "RETAIN"
+)
+
+// deletionProtectionAttribute is the deletion_protection attribute shared by resources that cannot be recovered once
+// deleted
+func deletionProtectionAttribute(name string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		MarkdownDescription: fmt.Sprintf("Whether Terraform is prevented from destroying the %s. "+
+			"It must be set to `false` and applied before the %s can be destroyed or replaced. Defaults to `false`.", name, name),
+	}
+}
+
+// deletionPolicyAttribute is the deletion_policy attribute shared by resources that cannot be recovered once deleted
+func deletionPolicyAttribute(name string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(deletionPolicyDelete),
+		MarkdownDescription: fmt.Sprintf("What happens to the %s in Buildkite when it is destroyed. "+
+			"Either `DELETE` to delete it, or `RETAIN` to only remove it from the Terraform state. Defaults to `DELETE`.", name),
+		Validators: []validator.String{
+			stringvalidator.OneOf(deletionPolicyDelete, deletionPolicyRetain),
+		},
+	}
+}
+
+// checkDeletion reports whether a resource being destroyed should be deleted from Buildkite. It adds an error if
+// deletion protection is enabled, and returns false without one if the deletion policy retains the resource.
+func checkDeletion(name string, protection types.Bool, policy types.String, diags *diag.Diagnostics) bool {
+	if protection.ValueBool() {
+		diags.AddError(
+			"Deletion protection is enabled",
+			fmt.Sprintf("The %s has deletion_protection set to true, so it cannot be destroyed or replaced. "+
+				"Set deletion_protection to false and apply that change first.", name),
+		)
+		return false
+	}
+
+	if policy.ValueString() == deletionPolicyRetain {
+		log.Printf("The %s has a deletion_policy of %s, only removing it from state", name, deletionPolicyRetain)
+		return false
+	}
+
+	return true
+}
+
+// setDeletionDefaults fills in the defaults when neither value is in state, such as after an import
+func setDeletionDefaults(protection *types.Bool, policy *types.String) {
+	if protection.IsNull() {
+		*protection = types.BoolValue(false)
+	}
+	if policy.IsNull() {
+		*policy = types.StringValue(deletionPolicyDelete)
+	}
+}
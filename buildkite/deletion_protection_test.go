@@ -0,0 +1,187 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBuildkiteDeletionProtection(t *testing.T) {
+	cases := map[string]struct {
+		// dependencies are kept in every step so only the protected resource is removed
+		dependencies string
+		resource     string
+	}{
+		"buildkite_pipeline.test": {
+			resource: `
+			resource "buildkite_pipeline" "test" {
+				name = "deletion protection %[1]s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+				deletion_protection = %[2]t
+			}`,
+		},
+		"buildkite_cluster.test": {
+			resource: `
+			resource "buildkite_cluster" "test" {
+				name = "deletion_protection_%[1]s"
+				deletion_protection = %[2]t
+			}`,
+		},
+		"buildkite_cluster_queue.test": {
+			dependencies: `
+			resource "buildkite_cluster" "cluster" {
+				name = "deletion_protection_%[1]s"
+			}`,
+			resource: `
+			resource "buildkite_cluster_queue" "test" {
+				cluster_id = buildkite_cluster.cluster.id
+				key = "deletion-protection"
+				deletion_protection = %[2]t
+			}`,
+		},
+		"buildkite_test_suite.test": {
+			dependencies: `
+			resource "buildkite_team" "team" {
+				name = "deletion protection %[1]s"
+				privacy = "VISIBLE"
+				default_team = false
+				default_member_role = "MEMBER"
+			}`,
+			resource: `
+			resource "buildkite_test_suite" "test" {
+				name = "deletion protection %[1]s"
+				default_branch = "main"
+				team_owner_id = buildkite_team.team.id
+				deletion_protection = %[2]t
+			}`,
+		},
+		"buildkite_registry.test": {
+			resource: `
+			resource "buildkite_registry" "test" {
+				name = "deletion-protection-%[1]s"
+				ecosystem = "java"
+				deletion_protection = %[2]t
+			}`,
+		},
+		"buildkite_team.test": {
+			resource: `
+			resource "buildkite_team" "test" {
+				name = "deletion protection %[1]s"
+				privacy = "VISIBLE"
+				default_team = false
+				default_member_role = "MEMBER"
+				deletion_protection = %[2]t
+			}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(fmt.Sprintf("%s cannot be destroyed while protected", name), func(t *testing.T) {
+			randName := acctest.RandString(10)
+			config := func(protected bool) string {
+				return fmt.Sprintf(c.dependencies+c.resource, randName, protected)
+			}
+
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: config(true),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(name, "deletion_protection", "true"),
+							resource.TestCheckResourceAttr(name, "deletion_policy", "DELETE"),
+						),
+					},
+					{
+						// removing the resource block is refused. the local keeps the configuration from being empty
+						Config:      fmt.Sprintf("locals {\n name = %[1]q\n}\n"+c.dependencies, randName),
+						ExpectError: regexp.MustCompile("Deletion protection is enabled"),
+					},
+					{
+						// the protection is turned off first, so the resource can be destroyed afterwards
+						Config: config(false),
+						Check:  resource.TestCheckResourceAttr(name, "deletion_protection", "false"),
+					},
+				},
+			})
+		})
+	}
+
+	t.Run("retained cluster is only removed from state", func(t *testing.T) {
+		randName := acctest.RandString(10)
+		var clusterID string
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+					resource "buildkite_cluster" "test" {
+						name = "deletion_policy_%s"
+						deletion_policy = "RETAIN"
+					}
+					`, randName),
+					Check: func(s *terraform.State) error {
+						clusterID = s.RootModule().Resources["buildkite_cluster.test"].Primary.ID
+						return nil
+					},
+				},
+				{
+					Config: `locals {}`,
+					Check: func(s *terraform.State) error {
+						r, err := getNode(context.Background(), genqlientGraphql, clusterID)
+						if err != nil {
+							return err
+						}
+						if _, ok := r.GetNode().(*getNodeNodeCluster); !ok {
+							return fmt.Errorf("expected cluster %s to be retained", clusterID)
+						}
+						// clean up the retained cluster
+						_, err = deleteCluster(context.Background(), genqlientGraphql, organizationID, clusterID)
+						return err
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestCheckDeletion(t *testing.T) {
+	cases := map[string]struct {
+		protection types.Bool
+		policy     types.String
+		delete     bool
+		hasError   bool
+	}{
+		"deletes by default":         {protection: types.BoolNull(), policy: types.StringNull(), delete: true},
+		"deletes with delete policy": {protection: types.BoolValue(false), policy: types.StringValue(deletionPolicyDelete), delete: true},
+		"retains with retain policy": {protection: types.BoolValue(false), policy: types.StringValue(deletionPolicyRetain)},
+		"errors when protected":      {protection: types.BoolValue(true), policy: types.StringValue(deletionPolicyDelete), hasError: true},
+		"errors when protected and retained": {
+			protection: types.BoolValue(true),
+			policy:     types.StringValue(deletionPolicyRetain),
+			hasError:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if shouldDelete := checkDeletion("cluster", c.protection, c.policy, &diags); shouldDelete != c.delete {
+				t.Errorf("expected delete to be %t, got %t", c.delete, shouldDelete)
+			}
+			if diags.HasError() != c.hasError {
+				t.Errorf("expected errors to be %t, got %v", c.hasError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// createdTokenKey is the private data key holding the ID of a token created by an ephemeral resource, so it can be
+// revoked when Terraform closes the resource
+const createdTokenKey = "created_token_id"
+
+var _ ephemeral.EphemeralResourceWithClose = &agentTokenEphemeralResource{}
+
+type agentTokenEphemeralResourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Id          types.String `tfsdk:"id"`
+	Token       types.String `tfsdk:"token"`
+	Uuid        types.String `tfsdk:"uuid"`
+}
+
+type agentTokenEphemeralResource struct {
+	client *Client
+}
+
+func newAgentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &agentTokenEphemeralResource{}
+}
+
+func (at *agentTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	at.client = req.ProviderData.(*Client)
+}
+
+func (agentTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+func (agentTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Use this ephemeral resource to get the value of a non-clustered agent token without it being stored in the
+			plan or state, for example to write it to a secret store using a write-only attribute.
+
+			When %s is set the value of that existing token is read, which pairs with a %s resource that has
+			%s set to %s. Otherwise a new agent token is created each time Terraform opens the ephemeral resource,
+			which happens during every plan and apply, and revoked when Terraform closes it at the end of that run. A
+			created token can only be used while Terraform runs, such as by a provisioner, so use %s to keep a token in a
+			secret store.
+
+			Ephemeral resources are available in Terraform 1.10 and later.
+		`, "`uuid`", "`buildkite_agent_token`", "`store_token`", "`false`", "`uuid`"),
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The description of the agent token to create. Used to help identify its use.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the agent token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The token value used by an agent to register with the API.",
+			},
+			"uuid": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The UUID of an existing agent token to read. If not set, a new agent token is created and revoked when Terraform closes the ephemeral resource.",
+			},
+		},
+	}
+}
+
+func (at *agentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data agentTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Uuid.IsNull() {
+		at.create(ctx, &data, resp)
+	} else {
+		at.read(ctx, &data, resp)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (at *agentTokenEphemeralResource) create(ctx context.Context, data *agentTokenEphemeralResourceModel, resp *ephemeral.OpenResponse) {
+	timeout, diags := at.client.timeouts.Create(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var r *createAgentTokenResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		org, err := at.client.GetOrganizationID()
+		if err == nil {
+			log.Printf("Creating agent token with description %s ...", data.Description.ValueString())
+			r, err = createAgentToken(ctx,
+				at.client.genqlient,
+				*org,
+				data.Description.ValueStringPointer(),
+			)
+		}
+
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create agent token",
+			fmt.Sprintf("Unable to create agent token: %s", describeError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setCreatedTokenID(ctx, resp.Private, r.AgentTokenCreate.AgentTokenEdge.Node.Id)...)
+
+	data.Description = types.StringPointerValue(r.AgentTokenCreate.AgentTokenEdge.Node.Description)
+	data.Id = types.StringValue(r.AgentTokenCreate.AgentTokenEdge.Node.Id)
+	data.Token = types.StringValue(r.AgentTokenCreate.TokenValue)
+	data.Uuid = types.StringValue(r.AgentTokenCreate.AgentTokenEdge.Node.Uuid)
+}
+
+func (at *agentTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	id, diags := createdTokenID(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	// tokens that were read rather than created are left alone
+	if resp.Diagnostics.HasError() || id == "" {
+		return
+	}
+
+	timeout, diags := at.client.timeouts.Delete(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		log.Printf("Revoking agent token %s ...", id)
+		_, err := revokeAgentToken(ctx,
+			at.client.genqlient,
+			id,
+			"Revoked by Terraform",
+		)
+
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to revoke agent token",
+			fmt.Sprintf("Unable to revoke agent token: %s", describeError(err)),
+		)
+	}
+}
+
+func (at *agentTokenEphemeralResource) read(ctx context.Context, data *agentTokenEphemeralResourceModel, resp *ephemeral.OpenResponse) {
+	timeout, diags := at.client.timeouts.Read(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var r *getAgentTokenValueResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		log.Printf("Reading agent token %s ...", data.Uuid.ValueString())
+		r, err = getAgentTokenValue(ctx,
+			at.client.genqlient,
+			fmt.Sprintf("%s/%s", at.client.organization, data.Uuid.ValueString()),
+		)
+
+		return retryContextError(err)
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to read agent token",
+			fmt.Sprintf("Unable to read agent token: %s", describeError(err)),
+		)
+		return
+	}
+
+	if r == nil || r.AgentToken.Id == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("uuid"),
+			"Unable to find agent token",
+			fmt.Sprintf("Could not find agent token with UUID \"%s\"", data.Uuid.ValueString()),
+		)
+		return
+	}
+	if r.AgentToken.RevokedAt != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("uuid"),
+			"Agent token is revoked",
+			fmt.Sprintf("Agent token with UUID \"%s\" was revoked and can no longer be used", data.Uuid.ValueString()),
+		)
+		return
+	}
+
+	data.Description = types.StringPointerValue(r.AgentToken.Description)
+	data.Id = types.StringValue(r.AgentToken.Id)
+	data.Token = types.StringValue(r.AgentToken.Token)
+	data.Uuid = types.StringValue(r.AgentToken.Uuid)
+}
+
+// privateData is the private data passed to ephemeral resources, whose type is internal to the framework
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setCreatedTokenID records the ID of a token created when opening an ephemeral resource
+func setCreatedTokenID(ctx context.Context, private privateData, id string) diag.Diagnostics {
+	value, err := json.Marshal(id)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to record created token", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, createdTokenKey, value)
+}
+
+// createdTokenID returns the ID of the token created when opening an ephemeral resource, or an empty string if an
+// existing token was read
+func createdTokenID(ctx context.Context, private privateData) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, createdTokenKey)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var id string
+	if err := json.Unmarshal(value, &id); err != nil {
+		diags.AddError("Unable to read created token", err.Error())
+	}
+	return id, diags
+}
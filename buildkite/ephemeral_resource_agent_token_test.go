@@ -0,0 +1,307 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// ephemeralProviderFactories adds the echo provider, which copies an ephemeral value into state so tests can check it
+func ephemeralProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"buildkite": providerserver.NewProtocol6WithError(New("testing")),
+		"echo":      echoprovider.NewProviderServer(),
+	}
+}
+
+func TestAccBuildkiteAgentTokenEphemeralResource(t *testing.T) {
+	t.Run("creates an agent token", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: ephemeralProviderFactories(),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+					ephemeral "buildkite_agent_token" "token" {
+						description = "Acceptance Test %s"
+					}
+
+					provider "echo" {
+						data = ephemeral.buildkite_agent_token.token
+					}
+
+					resource "echo" "token" {}
+					`, randName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("description"), knownvalue.StringExact("Acceptance Test "+randName)),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("reads an agent token kept out of state", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: ephemeralProviderFactories(),
+			CheckDestroy:             testAccCheckAgentTokenResourceDestroy,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+					resource "buildkite_agent_token" "token" {
+						description = "Acceptance Test %s"
+						store_token = false
+					}
+
+					ephemeral "buildkite_agent_token" "token" {
+						uuid = buildkite_agent_token.token.uuid
+					}
+
+					provider "echo" {
+						data = ephemeral.buildkite_agent_token.token
+					}
+
+					resource "echo" "token" {}
+					`, randName),
+					Check: resource.TestCheckNoResourceAttr("buildkite_agent_token.token", "token"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+						statecheck.CompareValuePairs(
+							"echo.token", tfjsonpath.New("data").AtMapKey("id"),
+							"buildkite_agent_token.token", tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+// ephemeralTestProvider serves the provider over the plugin protocol against a fake API, so tests can open and
+// close ephemeral resources the way Terraform does. The private data of an ephemeral resource can only be created
+// by the framework.
+type ephemeralTestProvider struct {
+	t       *testing.T
+	client  *Client
+	server  tfprotov6.ProviderServer
+	schemas *tfprotov6.GetProviderSchemaResponse
+}
+
+func newEphemeralTestProvider(t *testing.T) *ephemeralTestProvider {
+	t.Helper()
+	ctx := context.Background()
+
+	fake, client := fakeTestClient(t)
+	p := &ephemeralTestProvider{t: t, client: client, server: providerserver.NewProtocol6(New("testing"))()}
+
+	var err error
+	p.schemas, err = p.server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := p.config(p.schemas.Provider, map[string]tftypes.Value{
+		SchemaKeyOrganization: tftypes.NewValue(tftypes.String, fake.Organization),
+		SchemaKeyAPIToken:     tftypes.NewValue(tftypes.String, fake.Token),
+		SchemaKeyGraphqlURL:   tftypes.NewValue(tftypes.String, fake.GraphQLURL()),
+		SchemaKeyRestURL:      tftypes.NewValue(tftypes.String, fake.URL),
+	})
+	resp, err := p.server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{TerraformVersion: "1.10.0", Config: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := protocolDiagnostics(resp.Diagnostics); diags.HasError() {
+		t.Fatalf("unable to configure provider: %v", diags)
+	}
+	return p
+}
+
+// config encodes the given values for a schema, leaving the rest null
+func (p *ephemeralTestProvider) config(s *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	objectType := s.ValueType().(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	config, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return &config
+}
+
+// open opens an ephemeral resource with the given configuration values, returning its result and private data
+func (p *ephemeralTestProvider) open(r ephemeral.EphemeralResource, values map[string]tftypes.Value) (tfsdk.EphemeralResultData, []byte, diag.Diagnostics) {
+	p.t.Helper()
+	ctx := context.Background()
+
+	typeName := ephemeralTypeName(r)
+	s := p.schemas.EphemeralResourceSchemas[typeName]
+	resp, err := p.server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   p.config(s, values),
+	})
+	if err != nil {
+		p.t.Fatal(err)
+	}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	result := tfsdk.EphemeralResultData{Schema: schemaResp.Schema}
+	if resp.Result != nil {
+		if result.Raw, err = resp.Result.Unmarshal(s.ValueType()); err != nil {
+			p.t.Fatal(err)
+		}
+	}
+	return result, resp.Private, protocolDiagnostics(resp.Diagnostics)
+}
+
+// close closes an ephemeral resource with the private data returned when it was opened
+func (p *ephemeralTestProvider) close(r ephemeral.EphemeralResource, private []byte) diag.Diagnostics {
+	p.t.Helper()
+
+	resp, err := p.server.CloseEphemeralResource(context.Background(), &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: ephemeralTypeName(r),
+		Private:  private,
+	})
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return protocolDiagnostics(resp.Diagnostics)
+}
+
+func ephemeralTypeName(r ephemeral.EphemeralResource) string {
+	var resp ephemeral.MetadataResponse
+	r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "buildkite"}, &resp)
+	return resp.TypeName
+}
+
+func protocolDiagnostics(in []*tfprotov6.Diagnostic) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range in {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
+
+func TestAgentTokenEphemeralResourceOpen(t *testing.T) {
+	p := newEphemeralTestProvider(t)
+	ctx := context.Background()
+
+	revoked := func(t *testing.T, uuid string) bool {
+		t.Helper()
+		r, err := getAgentTokenValue(ctx, p.client.genqlient, fmt.Sprintf("%s/%s", p.client.organization, uuid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r.AgentToken.RevokedAt != nil
+	}
+
+	var created agentTokenEphemeralResourceModel
+	var private []byte
+	t.Run("creates a token", func(t *testing.T) {
+		var result tfsdk.EphemeralResultData
+		var diags diag.Diagnostics
+		result, private, diags = p.open(newAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"description": tftypes.NewValue(tftypes.String, "ephemeral"),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		result.Get(ctx, &created)
+
+		if created.Token.ValueString() == "" || created.Uuid.ValueString() == "" {
+			t.Fatalf("expected a token and uuid, got %+v", created)
+		}
+		if created.Description.ValueString() != "ephemeral" {
+			t.Errorf("expected description ephemeral, got %s", created.Description)
+		}
+	})
+
+	t.Run("reads an existing token", func(t *testing.T) {
+		result, readPrivate, diags := p.open(newAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"uuid": tftypes.NewValue(tftypes.String, created.Uuid.ValueString()),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		var read agentTokenEphemeralResourceModel
+		result.Get(ctx, &read)
+		if !read.Token.Equal(created.Token) || !read.Id.Equal(created.Id) || !read.Description.Equal(created.Description) {
+			t.Errorf("expected %+v, got %+v", created, read)
+		}
+
+		if diags := p.close(newAgentTokenEphemeralResource(), readPrivate); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if revoked(t, created.Uuid.ValueString()) {
+			t.Error("expected a token that was read to be left alone when closed")
+		}
+	})
+
+	t.Run("revokes a created token when closed", func(t *testing.T) {
+		if diags := p.close(newAgentTokenEphemeralResource(), private); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if !revoked(t, created.Uuid.ValueString()) {
+			t.Error("expected the created token to be revoked")
+		}
+	})
+
+	t.Run("rejects a revoked token", func(t *testing.T) {
+		_, _, diags := p.open(newAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"uuid": tftypes.NewValue(tftypes.String, created.Uuid.ValueString()),
+		})
+		if !diags.HasError() || !strings.Contains(diags[0].Detail(), "was revoked") {
+			t.Errorf("expected a revoked error, got %v", diags)
+		}
+	})
+
+	t.Run("rejects an unknown token", func(t *testing.T) {
+		_, _, diags := p.open(newAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"uuid": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+		})
+		if !diags.HasError() || diags[0].Summary() != "Unable to find agent token" {
+			t.Errorf("expected a not found error, got %v", diags)
+		}
+	})
+}
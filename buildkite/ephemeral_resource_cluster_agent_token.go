@@ -0,0 +1,296 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var _ ephemeral.EphemeralResourceWithClose = &clusterAgentTokenEphemeralResource{}
+
+type clusterAgentTokenEphemeralResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Uuid               types.String `tfsdk:"uuid"`
+	Description        types.String `tfsdk:"description"`
+	Token              types.String `tfsdk:"token"`
+	ClusterId          types.String `tfsdk:"cluster_id"`
+	ClusterUuid        types.String `tfsdk:"cluster_uuid"`
+	AllowedIpAddresses types.List   `tfsdk:"allowed_ip_addresses"`
+}
+
+type clusterAgentTokenEphemeralResource struct {
+	client *Client
+}
+
+func newClusterAgentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &clusterAgentTokenEphemeralResource{}
+}
+
+func (ct *clusterAgentTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	ct.client = req.ProviderData.(*Client)
+}
+
+func (ct *clusterAgentTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_agent_token"
+}
+
+func (ct *clusterAgentTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			Use this ephemeral resource to get the value of a cluster agent token without it being stored in the plan
+			or state, for example to write it to a secret store using a write-only attribute.
+
+			When %s is set the value of that existing token is read, which pairs with a %s resource that has %s set
+			to %s. Otherwise a new cluster agent token is created each time Terraform opens the ephemeral resource,
+			which happens during every plan and apply, and revoked when Terraform closes it at the end of that run. A
+			created token can only be used while Terraform runs, such as by a provisioner.
+
+			The Buildkite API is phasing out reading the value of existing cluster agent tokens. If the value is no
+			longer returned, create the token with a %s resource that has %s set to %s instead.
+
+			Ephemeral resources are available in Terraform 1.10 and later.
+		`, "`uuid`", "`buildkite_cluster_agent_token`", "`store_token`", "`false`", "`buildkite_cluster_agent_token`", "`store_token`", "`true`"),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the token.",
+			},
+			"uuid": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The UUID of an existing token to read. If not set, a new token is created and revoked when Terraform closes the ephemeral resource.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "A description about what this cluster agent token is used for. Required when creating a token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The token value used by an agent to register with the API.",
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The GraphQL ID of the Cluster that this Cluster Agent Token belongs to.",
+			},
+			"cluster_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the Cluster that this token belongs to.",
+			},
+			"allowed_ip_addresses": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "A list of CIDR-notation IPv4 addresses from which agents can use a created Cluster Agent Token. " +
+					"If not set, all IP addresses are allowed (the same as setting 0.0.0.0/0).",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("uuid")),
+				},
+			},
+		},
+	}
+}
+
+func (ct *clusterAgentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data clusterAgentTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Uuid.IsNull() {
+		ct.create(ctx, &data, resp)
+	} else {
+		ct.read(ctx, &data, resp)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (ct *clusterAgentTokenEphemeralResource) create(ctx context.Context, data *clusterAgentTokenEphemeralResourceModel, resp *ephemeral.OpenResponse) {
+	if data.Description.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Missing description",
+			"A description is required to create a cluster agent token",
+		)
+		return
+	}
+
+	timeout, diags := ct.client.timeouts.Create(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidrs := createCidrSliceFromList(data.AllowedIpAddresses)
+
+	var r *createClusterAgentTokenResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		org, err := ct.client.GetOrganizationID()
+		if err == nil {
+			log.Printf("Creating cluster agent token with description %s into cluster %s ...", data.Description.ValueString(), data.ClusterId.ValueString())
+			r, err = createClusterAgentToken(ctx,
+				ct.client.genqlient,
+				*org,
+				data.ClusterId.ValueString(),
+				data.Description.ValueString(),
+				strings.Join(cidrs, " "),
+			)
+		}
+
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Cluster Agent Token",
+			fmt.Sprintf("Unable to create Cluster Agent Token: %s", describeError(err)),
+		)
+		return
+	}
+
+	token := r.ClusterAgentTokenCreate.ClusterAgentToken
+	resp.Diagnostics.Append(setCreatedTokenID(ctx, resp.Private, token.Id)...)
+
+	data.Id = types.StringValue(token.Id)
+	data.Uuid = types.StringValue(token.Uuid)
+	data.Description = types.StringValue(token.Description)
+	data.Token = types.StringValue(r.ClusterAgentTokenCreate.TokenValue)
+	data.ClusterUuid = types.StringValue(token.Cluster.Uuid)
+}
+
+func (ct *clusterAgentTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	id, diags := createdTokenID(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	// tokens that were read rather than created are left alone
+	if resp.Diagnostics.HasError() || id == "" {
+		return
+	}
+
+	timeout, diags := ct.client.timeouts.Delete(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		org, err := ct.client.GetOrganizationID()
+		if err == nil {
+			log.Printf("Revoking cluster agent token %s ...", id)
+			_, err = revokeClusterAgentToken(ctx,
+				ct.client.genqlient,
+				*org,
+				id,
+			)
+		}
+
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to revoke Cluster Agent Token",
+			fmt.Sprintf("Unable to revoke Cluster Agent Token: %s", describeError(err)),
+		)
+	}
+}
+
+func (ct *clusterAgentTokenEphemeralResource) read(ctx context.Context, data *clusterAgentTokenEphemeralResourceModel, resp *ephemeral.OpenResponse) {
+	timeout, diags := ct.client.timeouts.Read(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clusterUuid string
+	var r *getClusterAgentTokenValuesResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		log.Printf("Reading cluster %s ...", data.ClusterId.ValueString())
+		node, err := getNode(ctx, ct.client.genqlient, data.ClusterId.ValueString())
+		if err != nil {
+			return retryContextError(err)
+		}
+		cluster, ok := node.GetNode().(*getNodeNodeCluster)
+		if !ok {
+			return nil
+		}
+		clusterUuid = cluster.Uuid
+
+		log.Printf("Getting cluster agent tokens for cluster %s ...", clusterUuid)
+		r, err = getClusterAgentTokenValues(ctx,
+			ct.client.genqlient,
+			ct.client.organization,
+			clusterUuid,
+		)
+
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Cluster Agent Tokens",
+			fmt.Sprintf("Unable to read Cluster Agent Tokens: %s", describeError(err)),
+		)
+		return
+	}
+	if clusterUuid == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cluster_id"),
+			"Unable to find cluster",
+			fmt.Sprintf("Could not find cluster with ID \"%s\"", data.ClusterId.ValueString()),
+		)
+		return
+	}
+
+	for _, edge := range r.Organization.Cluster.AgentTokens.Edges {
+		if edge.Node.Uuid != data.Uuid.ValueString() {
+			continue
+		}
+
+		if edge.Node.Token == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("uuid"),
+				"Cluster agent token value unavailable",
+				fmt.Sprintf("The API did not return the value of cluster agent token \"%s\". Omit uuid to create a new token instead.", data.Uuid.ValueString()),
+			)
+			return
+		}
+
+		data.Id = types.StringValue(edge.Node.Id)
+		data.Description = types.StringValue(edge.Node.Description)
+		data.Token = types.StringValue(edge.Node.Token)
+		data.ClusterUuid = types.StringValue(edge.Node.Cluster.Uuid)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("uuid"),
+		"Unable to find cluster agent token",
+		fmt.Sprintf("Could not find cluster agent token with UUID \"%s\" in cluster \"%s\"", data.Uuid.ValueString(), data.ClusterId.ValueString()),
+	)
+}
@@ -0,0 +1,164 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBuildkiteClusterAgentTokenEphemeralResource(t *testing.T) {
+	t.Run("reads a cluster agent token kept out of state", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: ephemeralProviderFactories(),
+			CheckDestroy:             testAccCheckClusterAgentTokenDestroy,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+					resource "buildkite_cluster" "cluster" {
+						name = "Test cluster %s"
+					}
+
+					resource "buildkite_cluster_agent_token" "token" {
+						cluster_id = buildkite_cluster.cluster.id
+						description = "Acceptance Test %s"
+						store_token = false
+					}
+
+					ephemeral "buildkite_cluster_agent_token" "token" {
+						cluster_id = buildkite_cluster.cluster.id
+						uuid = buildkite_cluster_agent_token.token.uuid
+					}
+
+					provider "echo" {
+						data = ephemeral.buildkite_cluster_agent_token.token
+					}
+
+					resource "echo" "token" {}
+					`, randName, randName),
+					Check: resource.TestCheckNoResourceAttr("buildkite_cluster_agent_token.token", "token"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+						statecheck.CompareValuePairs(
+							"echo.token", tfjsonpath.New("data").AtMapKey("id"),
+							"buildkite_cluster_agent_token.token", tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestClusterAgentTokenEphemeralResourceOpen(t *testing.T) {
+	p := newEphemeralTestProvider(t)
+	ctx := context.Background()
+
+	org, err := p.client.GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster, err := createCluster(ctx, p.client.genqlient, *org, "Ephemeral", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterID := tftypes.NewValue(tftypes.String, cluster.ClusterCreate.Cluster.Id)
+
+	var created clusterAgentTokenEphemeralResourceModel
+	var private []byte
+	t.Run("creates a token", func(t *testing.T) {
+		var result tfsdk.EphemeralResultData
+		var diags diag.Diagnostics
+		result, private, diags = p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id":  clusterID,
+			"description": tftypes.NewValue(tftypes.String, "ephemeral"),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		result.Get(ctx, &created)
+
+		if created.Token.ValueString() == "" || created.Uuid.ValueString() == "" {
+			t.Fatalf("expected a token and uuid, got %+v", created)
+		}
+		if created.ClusterUuid.ValueString() != cluster.ClusterCreate.Cluster.Uuid {
+			t.Errorf("expected cluster uuid %s, got %s", cluster.ClusterCreate.Cluster.Uuid, created.ClusterUuid)
+		}
+	})
+
+	t.Run("requires a description to create a token", func(t *testing.T) {
+		_, _, diags := p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id": clusterID,
+		})
+		if !diags.HasError() || diags[0].Summary() != "Missing description" {
+			t.Errorf("expected a missing description error, got %v", diags)
+		}
+	})
+
+	t.Run("reads an existing token", func(t *testing.T) {
+		result, readPrivate, diags := p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id": clusterID,
+			"uuid":       tftypes.NewValue(tftypes.String, created.Uuid.ValueString()),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		var read clusterAgentTokenEphemeralResourceModel
+		result.Get(ctx, &read)
+		if !read.Token.Equal(created.Token) || !read.Id.Equal(created.Id) || !read.Description.Equal(created.Description) {
+			t.Errorf("expected %+v, got %+v", created, read)
+		}
+
+		if diags := p.close(newClusterAgentTokenEphemeralResource(), readPrivate); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if _, _, diags := p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id": clusterID,
+			"uuid":       tftypes.NewValue(tftypes.String, created.Uuid.ValueString()),
+		}); diags.HasError() {
+			t.Errorf("expected a token that was read to be left alone when closed, got %v", diags)
+		}
+	})
+
+	t.Run("revokes a created token when closed", func(t *testing.T) {
+		if diags := p.close(newClusterAgentTokenEphemeralResource(), private); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+
+		_, _, diags := p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id": clusterID,
+			"uuid":       tftypes.NewValue(tftypes.String, created.Uuid.ValueString()),
+		})
+		if !diags.HasError() || diags[0].Summary() != "Unable to find cluster agent token" {
+			t.Errorf("expected the created token to be revoked, got %v", diags)
+		}
+	})
+
+	t.Run("rejects an unknown token", func(t *testing.T) {
+		_, _, diags := p.open(newClusterAgentTokenEphemeralResource(), map[string]tftypes.Value{
+			"cluster_id": clusterID,
+			"uuid":       tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000"),
+		})
+		if !diags.HasError() || diags[0].Summary() != "Unable to find cluster agent token" {
+			t.Errorf("expected a not found error, got %v", diags)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorKind classifies an error returned by the Buildkite API so callers can decide how to react to it
+type errorKind int
+
+const (
+	errorKindUnknown errorKind = iota
+	errorKindNotFound
+	errorKindPermissionDenied
+	errorKindValidation
+	errorKindRateLimited
+	errorKindServer
+)
+
+// graphQLHTTPError is returned for GraphQL requests that do not receive a 200 response. Its message matches the one
+// genqlient would otherwise produce.
+type graphQLHTTPError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *graphQLHTTPError) Error() string {
+	return fmt.Sprintf("returned error %v: %s", e.Status, e.Body)
+}
+
+// graphQLDoer performs GraphQL requests for genqlient, turning unsuccessful responses into a graphQLHTTPError so
+// the status code is available for classifying the error
+type graphQLDoer struct {
+	client *http.Client
+}
+
+func newGraphQLDoer(client *http.Client) *graphQLDoer {
+	return &graphQLDoer{client: client}
+}
+
+func (d *graphQLDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.client.Do(req)
+	if err != nil || resp.StatusCode == http.StatusOK {
+		return resp, err
+	}
+	return nil, newGraphQLHTTPError(resp)
+}
+
+// newGraphQLHTTPError reads and closes the body of an unsuccessful GraphQL response
+func newGraphQLHTTPError(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		body = []byte(fmt.Sprintf("<unreadable: %v>", err))
+	}
+	return &graphQLHTTPError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body)}
+}
+
+// graphQLErrorCodes maps the codes GraphQL errors can carry in their extensions to a kind of error
+var graphQLErrorCodes = map[string]errorKind{
+	"NOT_FOUND":             errorKindNotFound,
+	"FORBIDDEN":             errorKindPermissionDenied,
+	"PERMISSION_DENIED":     errorKindPermissionDenied,
+	"UNAUTHORIZED":          errorKindPermissionDenied,
+	"UNAUTHENTICATED":       errorKindPermissionDenied,
+	"BAD_USER_INPUT":        errorKindValidation,
+	"INVALID_INPUT":         errorKindValidation,
+	"VALIDATION_ERROR":      errorKindValidation,
+	"UNPROCESSABLE_ENTITY":  errorKindValidation,
+	"RATE_LIMITED":          errorKindRateLimited,
+	"TOO_MANY_REQUESTS":     errorKindRateLimited,
+	"INTERNAL_SERVER_ERROR": errorKindServer,
+}
+
+// graphQLErrorMessages classify GraphQL errors without a code by their message. They are anchored to the start of
+// the message of a single error, so text the API echoes back from the request cannot match them.
+var graphQLErrorMessages = []struct {
+	pattern *regexp.Regexp
+	kind    errorKind
+}{
+	{regexp.MustCompile(`^No \w+( \w+)* found`), errorKindNotFound},
+	{regexp.MustCompile(`^Couldn't find \w+`), errorKindNotFound},
+	{regexp.MustCompile(`^(You don't have|You do not have|Not authorized|Forbidden)`), errorKindPermissionDenied},
+	{regexp.MustCompile(`^(API rate limit|Rate limit) exceeded`), errorKindRateLimited},
+}
+
+// classifyError determines the kind of a GraphQL or REST API error
+func classifyError(err error) errorKind {
+	if err == nil {
+		return errorKindUnknown
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return classifyStatus(apiErr.StatusCode)
+	}
+
+	var httpErr *graphQLHTTPError
+	if errors.As(err, &httpErr) {
+		return classifyStatus(httpErr.StatusCode)
+	}
+
+	var gqlErrs gqlerror.List
+	if errors.As(err, &gqlErrs) {
+		for _, gqlErr := range gqlErrs {
+			if kind := classifyGraphQLError(gqlErr); kind != errorKindUnknown {
+				return kind
+			}
+		}
+		return errorKindUnknown
+	}
+
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return classifyGraphQLError(gqlErr)
+	}
+
+	return errorKindUnknown
+}
+
+func classifyStatus(status int) errorKind {
+	switch {
+	case status == http.StatusNotFound:
+		return errorKindNotFound
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return errorKindPermissionDenied
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return errorKindValidation
+	case status == http.StatusTooManyRequests:
+		return errorKindRateLimited
+	case status >= 500:
+		return errorKindServer
+	}
+	return errorKindUnknown
+}
+
+func classifyGraphQLError(err *gqlerror.Error) errorKind {
+	if code, ok := err.Extensions["code"].(string); ok {
+		if kind, ok := graphQLErrorCodes[strings.ToUpper(code)]; ok {
+			return kind
+		}
+	}
+
+	for _, message := range graphQLErrorMessages {
+		if message.pattern.MatchString(err.Message) {
+			return message.kind
+		}
+	}
+
+	return errorKindUnknown
+}
+
+// isNotFoundError returns true if the error indicates the resource does not exist
+func isNotFoundError(err error) bool {
+	return classifyError(err) == errorKindNotFound
+}
+
+// isPermissionDeniedError returns true if the API token is not allowed to perform the request
+func isPermissionDeniedError(err error) bool {
+	return classifyError(err) == errorKindPermissionDenied
+}
+
+// isValidationError returns true if the API rejected the request's input
+func isValidationError(err error) bool {
+	return classifyError(err) == errorKindValidation
+}
+
+// isRateLimitedError returns true if the request was rejected by API rate limiting
+func isRateLimitedError(err error) bool {
+	return classifyError(err) == errorKindRateLimited
+}
+
+// isRetryableError returns true if the request may succeed if it is made again
+func isRetryableError(err error) bool {
+	kind := classifyError(err)
+	return kind == errorKindRateLimited || kind == errorKindServer
+}
+
+// describeError returns the message for an API error along with guidance on resolving it, for use in diagnostics
+func describeError(err error) string {
+	switch {
+	case isPermissionDeniedError(err):
+		return err.Error() + "\n\nThe API token does not have permission for this request. Check the token's scopes and that " +
+			"its user has access to the resource."
+	case isValidationError(err):
+		return err.Error() + "\n\nThe Buildkite API rejected the values in the request. Correct them in the configuration and " +
+			"try again."
+	case isRateLimitedError(err):
+		return err.Error() + "\n\nThe Buildkite API rate limit was exceeded. Wait for the limit to reset and try again."
+	}
+	return err.Error()
+}
+
+// describeScopedError is describeError for REST API requests that need the given scopes. When permission is denied and
+// the API token is known to lack some of them, the missing scopes are named.
+func (client *Client) describeScopedError(err error, scopes ...string) string {
+	if !isPermissionDeniedError(err) || client.tokenScopes == nil {
+		return describeError(err)
+	}
+
+	missing := client.missingScopes(scopes)
+	if len(missing) == 0 {
+		return describeError(err)
+	}
+	return fmt.Sprintf("%s\n\nThe API token is missing the %s scopes needed for this request. Add them to the token in "+
+		"your API access token settings.", err.Error(), strings.Join(missing, ", "))
+}
+
+// removeIfNotFound removes a resource from state with a warning when err shows it no longer exists, so it is
+// recreated rather than failing the refresh. It reports whether the resource was removed.
+func removeIfNotFound(ctx context.Context, err error, name string, resp *resource.ReadResponse) bool {
+	if !isNotFoundError(err) {
+		return false
+	}
+
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("%s not found", name),
+		fmt.Sprintf("%s no longer exists, removing it from state: %s", name, err.Error()),
+	)
+	resp.State.RemoveResource(ctx)
+	return true
+}
@@ -0,0 +1,119 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	genqlient "github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestClassifyError(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected errorKind
+	}{
+		"nil": {
+			err:      nil,
+			expected: errorKindUnknown,
+		},
+		"unrelated error": {
+			err:      errors.New("pipeline not found"),
+			expected: errorKindUnknown,
+		},
+		"missing node": {
+			err:      gqlerror.List{{Message: `No pipeline found with ID "abc"`, Path: ast.Path{ast.PathName("node")}}},
+			expected: errorKindNotFound,
+		},
+		"not found text in a validation message": {
+			err:      gqlerror.List{{Message: "pipeline.trigger_build.pipeline: source_pipeline not found", Path: ast.Path{ast.PathName("ruleCreate")}}},
+			expected: errorKindUnknown,
+		},
+		"extension code": {
+			err:      gqlerror.List{{Message: "Something went wrong", Extensions: map[string]interface{}{"code": "forbidden"}}},
+			expected: errorKindPermissionDenied,
+		},
+		"first classified error wins": {
+			err: gqlerror.List{
+				{Message: "Name can't be blank"},
+				{Message: "Rate limit exceeded", Extensions: map[string]interface{}{"code": "RATE_LIMITED"}},
+			},
+			expected: errorKindRateLimited,
+		},
+		"wrapped by retry": {
+			err:      fmt.Errorf("reading: %w", &retry.TimeoutError{LastError: gqlerror.List{{Message: "No team found"}}}),
+			expected: errorKindNotFound,
+		},
+		"graphql HTTP status": {
+			err:      &graphQLHTTPError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"},
+			expected: errorKindServer,
+		},
+		"REST status": {
+			err:      &APIError{StatusCode: http.StatusUnprocessableEntity},
+			expected: errorKindValidation,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := classifyError(testCase.err); got != testCase.expected {
+				t.Errorf("expected kind %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestGraphQLDoer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, "slow down")
+	}))
+	defer server.Close()
+
+	client := genqlient.NewClient(server.URL, newGraphQLDoer(server.Client()))
+	err := client.MakeRequest(context.Background(), &genqlient.Request{Query: "query { viewer { id } }"}, &genqlient.Response{})
+
+	if classifyError(err) != errorKindRateLimited || !isRetryableError(err) {
+		t.Fatalf("expected a rate limited error, got %v", err)
+	}
+	if err.Error() != "returned error 429 Too Many Requests: slow down" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+	if !strings.Contains(describeError(err), "rate limit was exceeded") {
+		t.Errorf("expected guidance in the description, got %q", describeError(err))
+	}
+}
+
+func TestDescribeScopedError(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusForbidden, Message: "Forbidden"}
+
+	t.Run("names the missing scopes", func(t *testing.T) {
+		client := &Client{tokenScopes: []string{"graphql", "read_registries"}}
+
+		description := client.describeScopedError(err, "read_registries", "write_registries")
+		if !strings.Contains(description, "missing the write_registries scopes") {
+			t.Errorf("expected the missing scope to be named, got %q", description)
+		}
+	})
+
+	t.Run("falls back to the generic guidance", func(t *testing.T) {
+		client := &Client{tokenScopes: []string{"graphql", "write_registries"}}
+
+		description := client.describeScopedError(err, "write_registries")
+		if description != describeError(err) {
+			t.Errorf("expected the generic description, got %q", description)
+		}
+
+		client = &Client{}
+		if description := client.describeScopedError(err, "write_registries"); description != describeError(err) {
+			t.Errorf("expected the generic description for an unvalidated token, got %q", description)
+		}
+	})
+}
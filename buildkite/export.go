@@ -0,0 +1,889 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportResourceTypes are the resource types Export can write, in the order they are written. Test suites and their
+// team access are not exported, as buildkite_test_suite cannot be imported.
+var exportResourceTypes = []string{
+	"buildkite_cluster",
+	"buildkite_cluster_queue",
+	"buildkite_cluster_default_queue",
+	"buildkite_team",
+	"buildkite_team_member",
+	"buildkite_pipeline_template",
+	"buildkite_pipeline",
+	"buildkite_pipeline_team",
+	"buildkite_pipeline_schedule",
+	"buildkite_organization_rule",
+	"buildkite_registry",
+}
+
+// ExportOptions configures the organization and resources written by Export. The connection settings default to the
+// same environment variables and endpoints as the provider.
+type ExportOptions struct {
+	Organization string
+	APIToken     string
+	GraphqlURL   string
+	RestURL      string
+	UserAgent    string
+
+	// ResourceTypes limits the export to these resource types, given with or without the buildkite_ prefix. Every
+	// supported type is exported when it is empty.
+	ResourceTypes []string
+	// Team limits the export to the team with this slug, its members, and the pipelines and registries it can access
+	Team string
+	// Cluster limits the export to the cluster with this name, its queues and its pipelines
+	Cluster string
+}
+
+type exportQueue = getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue
+type exportTeamMember = getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember
+type exportPipelineTeam = PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline
+
+// exporter holds the objects read from the organization while they are written as configuration
+type exporter struct {
+	client *Client
+	types  map[string]bool
+
+	clusters      []ClusterFields
+	queues        map[string][]exportQueue
+	teams         []TeamFields
+	members       map[string][]exportTeamMember
+	templates     []PipelineTemplateFields
+	pipelines     []PipelineFields
+	pipelineTeams map[string][]exportPipelineTeam
+	schedules     map[string][]PipelineScheduleValues
+	rules         []OrganizationRuleFields
+	registries    []registryResponse
+
+	// names holds the resource names in use for each resource type
+	names map[string]map[string]bool
+	// refs maps the IDs and UUIDs of exported objects to the attributes of their resources holding them
+	refs map[string]hcl.Traversal
+}
+
+// Export writes Terraform configuration for the resources of an organization to w. Each resource is followed by an
+// import block, and attributes referring to other exported resources are written as references to them.
+func Export(ctx context.Context, opts ExportOptions, w io.Writer) error {
+	types, err := exportTypes(opts.ResourceTypes)
+	if err != nil {
+		return err
+	}
+
+	config := clientConfig{
+		org:        opts.Organization,
+		apiToken:   opts.APIToken,
+		graphqlURL: opts.GraphqlURL,
+		restURL:    opts.RestURL,
+		userAgent:  opts.UserAgent,
+	}
+	if config.org == "" {
+		config.org = getenv("BUILDKITE_ORGANIZATION_SLUG")
+	}
+	if config.apiToken == "" {
+		config.apiToken = os.Getenv("BUILDKITE_API_TOKEN")
+	}
+	if config.graphqlURL == "" {
+		config.graphqlURL = defaultGraphqlEndpoint
+		if v, ok := os.LookupEnv("BUILDKITE_GRAPHQL_URL"); ok {
+			config.graphqlURL = v
+		}
+	}
+	if config.restURL == "" {
+		config.restURL = defaultRestEndpoint
+		if v, ok := os.LookupEnv("BUILDKITE_REST_URL"); ok {
+			config.restURL = v
+		}
+	}
+	if config.org == "" || config.apiToken == "" {
+		return errors.New("an organization and API token are required, set them with BUILDKITE_ORGANIZATION_SLUG and BUILDKITE_API_TOKEN")
+	}
+
+	client, err := NewClient(&config)
+	if err != nil {
+		return err
+	}
+
+	e := &exporter{
+		client:        client,
+		types:         types,
+		queues:        make(map[string][]exportQueue),
+		members:       make(map[string][]exportTeamMember),
+		pipelineTeams: make(map[string][]exportPipelineTeam),
+		schedules:     make(map[string][]PipelineScheduleValues),
+		names:         make(map[string]map[string]bool),
+		refs:          make(map[string]hcl.Traversal),
+	}
+
+	if err := e.read(ctx, opts.Team, opts.Cluster); err != nil {
+		return err
+	}
+
+	file := hclwrite.NewEmptyFile()
+	e.write(file.Body())
+
+	_, err = w.Write(hclwrite.Format(file.Bytes()))
+	return err
+}
+
+// exportTypes returns the set of resource types to export, normalizing their names
+func exportTypes(requested []string) (map[string]bool, error) {
+	types := make(map[string]bool)
+	for _, name := range requested {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !strings.HasPrefix(name, "buildkite_") {
+			name = "buildkite_" + name
+		}
+		if !slices.Contains(exportResourceTypes, name) {
+			return nil, fmt.Errorf("unable to export resources of type %s, supported types are: %s", name, strings.Join(exportResourceTypes, ", "))
+		}
+		types[name] = true
+	}
+
+	if len(types) == 0 {
+		for _, name := range exportResourceTypes {
+			types[name] = true
+		}
+	}
+	return types, nil
+}
+
+// read fetches the objects to export, narrowed to those of the team and cluster when given
+func (e *exporter) read(ctx context.Context, teamSlug, clusterName string) error {
+	if err := e.readClusters(ctx); err != nil {
+		return fmt.Errorf("unable to read clusters: %w", err)
+	}
+	if err := e.readTeams(ctx); err != nil {
+		return fmt.Errorf("unable to read teams: %w", err)
+	}
+	if err := e.readPipelines(ctx); err != nil {
+		return fmt.Errorf("unable to read pipelines: %w", err)
+	}
+
+	var team *TeamFields
+	if teamSlug != "" {
+		i := slices.IndexFunc(e.teams, func(t TeamFields) bool { return t.Slug == teamSlug })
+		if i < 0 {
+			return fmt.Errorf("unable to find team with slug %q", teamSlug)
+		}
+		team = &e.teams[i]
+		e.teams = []TeamFields{*team}
+	}
+
+	var cluster *ClusterFields
+	if clusterName != "" {
+		i := slices.IndexFunc(e.clusters, func(c ClusterFields) bool { return c.Name == clusterName })
+		if i < 0 {
+			return fmt.Errorf("unable to find cluster with name %q", clusterName)
+		}
+		cluster = &e.clusters[i]
+		e.clusters = []ClusterFields{*cluster}
+	}
+
+	// teams and clusters are shared across the organization, so they are only exported when selected
+	if team != nil && cluster == nil {
+		e.clusters = nil
+	}
+	if cluster != nil && team == nil {
+		e.teams = nil
+	}
+
+	var pipelines []PipelineFields
+	for _, pipeline := range e.pipelines {
+		if cluster != nil && (pipeline.Cluster.Id == nil || *pipeline.Cluster.Id != cluster.Id) {
+			continue
+		}
+
+		// the teams of a pipeline are only needed to export its team access or to filter by team
+		if e.types["buildkite_pipeline_team"] || team != nil {
+			teams, err := e.readPipelineTeams(ctx, pipeline)
+			if err != nil {
+				return fmt.Errorf("unable to read teams of pipeline %s: %w", pipeline.Slug, err)
+			}
+			if team != nil {
+				i := slices.IndexFunc(teams, func(t exportPipelineTeam) bool { return t.Team.Id == team.Id })
+				if i < 0 {
+					continue
+				}
+				teams = teams[i : i+1]
+			}
+			e.pipelineTeams[pipeline.Id] = teams
+		}
+
+		pipelines = append(pipelines, pipeline)
+	}
+	e.pipelines = pipelines
+
+	if e.types["buildkite_cluster_queue"] || e.types["buildkite_cluster_default_queue"] {
+		for _, cluster := range e.clusters {
+			queues, err := e.readQueues(ctx, cluster)
+			if err != nil {
+				return fmt.Errorf("unable to read queues of cluster %s: %w", cluster.Name, err)
+			}
+			e.queues[cluster.Id] = queues
+		}
+	}
+
+	if e.types["buildkite_team_member"] {
+		for _, team := range e.teams {
+			members, err := e.readTeamMembers(ctx, team)
+			if err != nil {
+				return fmt.Errorf("unable to read members of team %s: %w", team.Slug, err)
+			}
+			e.members[team.Id] = members
+		}
+	}
+
+	if e.types["buildkite_pipeline_schedule"] {
+		for _, pipeline := range e.pipelines {
+			schedules, err := e.readSchedules(ctx, pipeline)
+			if err != nil {
+				return fmt.Errorf("unable to read schedules of pipeline %s: %w", pipeline.Slug, err)
+			}
+			e.schedules[pipeline.Id] = schedules
+		}
+	}
+
+	filtered := team != nil || cluster != nil
+
+	if e.types["buildkite_pipeline_template"] {
+		if err := e.readTemplates(ctx); err != nil {
+			return fmt.Errorf("unable to read pipeline templates: %w", err)
+		}
+		if filtered {
+			e.templates = slices.DeleteFunc(e.templates, func(template PipelineTemplateFields) bool {
+				return !slices.ContainsFunc(e.pipelines, func(p PipelineFields) bool {
+					return p.PipelineTemplate.Id != nil && *p.PipelineTemplate.Id == template.Id
+				})
+			})
+		}
+	}
+
+	if e.types["buildkite_organization_rule"] {
+		if err := e.readRules(ctx); err != nil {
+			return fmt.Errorf("unable to read organization rules: %w", err)
+		}
+		if filtered {
+			e.rules = slices.DeleteFunc(e.rules, func(rule OrganizationRuleFields) bool {
+				source, target := ruleSourceTarget(rule)
+				return !slices.ContainsFunc(e.pipelines, func(p PipelineFields) bool {
+					return p.Id == source || p.Id == target
+				})
+			})
+		}
+	}
+
+	if e.types["buildkite_registry"] && (team != nil || cluster == nil) {
+		registries, err := restList[registryResponse](ctx, e.client, fmt.Sprintf("/v2/packages/organizations/%s/registries", e.client.organization))
+		if err != nil {
+			return fmt.Errorf("unable to read registries: %w", err)
+		}
+		if team != nil {
+			registries = slices.DeleteFunc(registries, func(r registryResponse) bool {
+				return !slices.Contains(r.TeamIDs, team.Id) && !slices.Contains(r.TeamIDs, team.Uuid)
+			})
+		}
+		e.registries = registries
+	}
+
+	return nil
+}
+
+func (e *exporter) readClusters(ctx context.Context) error {
+	var cursor *string
+	for {
+		log.Printf("Listing clusters of organization %s ...", e.client.organization)
+		r, err := getClusterByName(ctx, e.client.genqlient, e.client.organization, cursor)
+		if err != nil {
+			return err
+		}
+		for _, edge := range r.Organization.Clusters.Edges {
+			e.clusters = append(e.clusters, edge.Node.ClusterFields)
+		}
+		if !r.Organization.Clusters.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.Clusters.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readQueues(ctx context.Context, cluster ClusterFields) ([]exportQueue, error) {
+	var queues []exportQueue
+	var cursor *string
+	for {
+		log.Printf("Listing queues of cluster %s ...", cluster.Name)
+		r, err := getClusterQueues(ctx, e.client.genqlient, e.client.organization, cluster.Uuid, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range r.Organization.Cluster.Queues.Edges {
+			queues = append(queues, edge.Node)
+		}
+		if !r.Organization.Cluster.Queues.PageInfo.HasNextPage {
+			return queues, nil
+		}
+		cursor = &r.Organization.Cluster.Queues.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readTeams(ctx context.Context) error {
+	var cursor *string
+	for {
+		log.Printf("Listing teams of organization %s ...", e.client.organization)
+		r, err := getOrganizationTeams(ctx, e.client.genqlient, e.client.organization, cursor)
+		if err != nil {
+			return err
+		}
+		for _, edge := range r.Organization.Teams.Edges {
+			e.teams = append(e.teams, edge.Node.TeamFields)
+		}
+		if !r.Organization.Teams.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.Teams.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readTeamMembers(ctx context.Context, team TeamFields) ([]exportTeamMember, error) {
+	var members []exportTeamMember
+	var cursor *string
+	for {
+		log.Printf("Listing members of team %s ...", team.Slug)
+		r, err := getTeamMembers(ctx, e.client.genqlient, fmt.Sprintf("%s/%s", e.client.organization, team.Slug), cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range r.Team.Members.Edges {
+			members = append(members, edge.Node)
+		}
+		if !r.Team.Members.PageInfo.HasNextPage {
+			return members, nil
+		}
+		cursor = &r.Team.Members.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readPipelines(ctx context.Context) error {
+	var cursor *string
+	for {
+		log.Printf("Listing pipelines of organization %s ...", e.client.organization)
+		r, err := getOrganizationPipelines(ctx, e.client.genqlient, e.client.organization, cursor)
+		if err != nil {
+			return err
+		}
+		for _, edge := range r.Organization.Pipelines.Edges {
+			e.pipelines = append(e.pipelines, edge.Node.PipelineFields)
+		}
+		if !r.Organization.Pipelines.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.Pipelines.PageInfo.EndCursor
+	}
+}
+
+// readPipelineTeams returns the teams with access to a pipeline. The first few are read along with the pipeline,
+// so the rest are only requested for pipelines with more.
+func (e *exporter) readPipelineTeams(ctx context.Context, pipeline PipelineFields) ([]exportPipelineTeam, error) {
+	var teams []exportPipelineTeam
+	for _, edge := range pipeline.Teams.Edges {
+		teams = append(teams, edge.Node)
+	}
+
+	pageInfo := pipeline.Teams.PageInfo
+	for pageInfo.HasNextPage {
+		log.Printf("Listing teams of pipeline %s ...", pipeline.Slug)
+		r, err := getPipelineTeams(ctx, e.client.genqlient, fmt.Sprintf("%s/%s", e.client.organization, pipeline.Slug), pageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range r.Pipeline.Teams.Edges {
+			teams = append(teams, edge.Node)
+		}
+		pageInfo = r.Pipeline.Teams.PageInfo
+	}
+	return teams, nil
+}
+
+func (e *exporter) readSchedules(ctx context.Context, pipeline PipelineFields) ([]PipelineScheduleValues, error) {
+	log.Printf("Listing schedules of pipeline %s ...", pipeline.Slug)
+	r, err := getPipelineSchedules(ctx, e.client.genqlient, fmt.Sprintf("%s/%s", e.client.organization, pipeline.Slug))
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []PipelineScheduleValues
+	for _, edge := range r.Pipeline.Schedules.Edges {
+		schedules = append(schedules, edge.Node.PipelineScheduleValues)
+	}
+	return schedules, nil
+}
+
+func (e *exporter) readTemplates(ctx context.Context) error {
+	var cursor *string
+	for {
+		log.Printf("Listing pipeline templates of organization %s ...", e.client.organization)
+		r, err := getPipelineTemplates(ctx, e.client.genqlient, e.client.organization, cursor)
+		if err != nil {
+			return err
+		}
+		for _, edge := range r.Organization.PipelineTemplates.Edges {
+			e.templates = append(e.templates, edge.Node.PipelineTemplateFields)
+		}
+		if !r.Organization.PipelineTemplates.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.PipelineTemplates.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readRules(ctx context.Context) error {
+	var cursor *string
+	for {
+		log.Printf("Listing rules of organization %s ...", e.client.organization)
+		r, err := getOrganizationRules(ctx, e.client.genqlient, e.client.organization, cursor)
+		if err != nil {
+			return err
+		}
+		for _, edge := range r.Organization.Rules.Edges {
+			e.rules = append(e.rules, edge.Node.OrganizationRuleFields)
+		}
+		if !r.Organization.Rules.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.Rules.PageInfo.EndCursor
+	}
+}
+
+// ruleSourceTarget returns the GraphQL IDs of the pipelines a rule applies to, which are empty for other sources and
+// targets
+func ruleSourceTarget(rule OrganizationRuleFields) (string, string) {
+	var source, target string
+	if s, ok := rule.Source.(*OrganizationRuleFieldsSourcePipeline); ok && s != nil {
+		source = s.Id
+	}
+	if t, ok := rule.Target.(*OrganizationRuleFieldsTargetPipeline); ok && t != nil {
+		target = t.Id
+	}
+	return source, target
+}
+
+// exportResource is a resource to write along with the identifier it is imported with
+type exportResource struct {
+	address  hcl.Traversal
+	importID string
+	write    func(body *hclwrite.Body)
+}
+
+// write writes every selected resource. All resources are named before any are written, so references can point at
+// resources written later.
+func (e *exporter) write(body *hclwrite.Body) {
+	var resources []exportResource
+	for _, resourceType := range exportResourceTypes {
+		if e.types[resourceType] {
+			resources = append(resources, e.resources(resourceType)...)
+		}
+	}
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("resource", []string{r.address.RootName(), r.address[1].(hcl.TraverseAttr).Name})
+		r.write(block.Body())
+
+		body.AppendNewline()
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", r.address)
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(r.importID))
+	}
+}
+
+// resources names the exported objects of a resource type, returning how to write each of them
+func (e *exporter) resources(resourceType string) []exportResource {
+	var resources []exportResource
+	add := func(name, importID, id, uuid string, write func(body *hclwrite.Body)) {
+		address := e.address(resourceType, name)
+		if id != "" {
+			e.refs[id] = append(slices.Clone(address), hcl.TraverseAttr{Name: "id"})
+		}
+		if uuid != "" {
+			e.refs[uuid] = append(slices.Clone(address), hcl.TraverseAttr{Name: "uuid"})
+		}
+		resources = append(resources, exportResource{address: address, importID: importID, write: write})
+	}
+
+	switch resourceType {
+	case "buildkite_cluster":
+		for _, cluster := range e.clusters {
+			add(cluster.Name, cluster.Name, cluster.Id, cluster.Uuid, func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(cluster.Name))
+				setOptionalString(body, "description", cluster.Description)
+				setOptionalString(body, "emoji", cluster.Emoji)
+				setOptionalString(body, "color", cluster.Color)
+			})
+		}
+
+	case "buildkite_cluster_queue":
+		for _, cluster := range e.clusters {
+			for _, queue := range e.queues[cluster.Id] {
+				add(cluster.Name+"_"+queue.Key, cluster.Name+"/"+queue.Key, queue.Id, queue.Uuid, func(body *hclwrite.Body) {
+					e.setID(body, "cluster_id", cluster.Id)
+					body.SetAttributeValue("key", cty.StringVal(queue.Key))
+					setOptionalString(body, "description", queue.Description)
+					if queue.DispatchPaused {
+						body.SetAttributeValue("dispatch_paused", cty.True)
+						setOptionalString(body, "dispatch_paused_note", queue.DispatchPausedNote)
+					}
+					if queue.Hosted {
+						body.SetAttributeValue("hosted_agents", hostedAgentsValue(queue.HostedAgents.HostedAgentsQueueSettingsValues))
+					}
+				})
+			}
+		}
+
+	case "buildkite_cluster_default_queue":
+		for _, cluster := range e.clusters {
+			if cluster.DefaultQueue == nil || !slices.ContainsFunc(e.queues[cluster.Id], func(q exportQueue) bool { return q.Id == cluster.DefaultQueue.Id }) {
+				continue
+			}
+			add(cluster.Name, cluster.Name, "", "", func(body *hclwrite.Body) {
+				e.setID(body, "cluster_id", cluster.Id)
+				e.setID(body, "queue_id", cluster.DefaultQueue.Id)
+			})
+		}
+
+	case "buildkite_team":
+		for _, team := range e.teams {
+			add(team.Slug, team.Slug, team.Id, team.Uuid, func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(team.Name))
+				if team.Description != "" {
+					body.SetAttributeValue("description", cty.StringVal(team.Description))
+				}
+				body.SetAttributeValue("privacy", cty.StringVal(string(team.Privacy)))
+				body.SetAttributeValue("default_team", cty.BoolVal(team.IsDefaultTeam))
+				body.SetAttributeValue("default_member_role", cty.StringVal(string(team.DefaultMemberRole)))
+				if team.MembersCanCreatePipelines {
+					body.SetAttributeValue("members_can_create_pipelines", cty.True)
+				}
+			})
+		}
+
+	case "buildkite_team_member":
+		for _, team := range e.teams {
+			for _, member := range e.members[team.Id] {
+				add(team.Slug+"_"+member.User.Email, team.Slug+"/"+member.User.Email, member.Id, "", func(body *hclwrite.Body) {
+					e.setID(body, "team_id", team.Id)
+					body.SetAttributeValue("user_id", cty.StringVal(member.User.Id))
+					body.SetAttributeValue("role", cty.StringVal(string(member.Role)))
+				})
+			}
+		}
+
+	case "buildkite_pipeline_template":
+		for _, template := range e.templates {
+			add(template.Name, template.Id, template.Id, template.Uuid, func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(template.Name))
+				setOptionalString(body, "description", template.Description)
+				body.SetAttributeRaw("configuration", multilineStringTokens(template.Configuration))
+				body.SetAttributeValue("available", cty.BoolVal(template.Available))
+			})
+		}
+
+	case "buildkite_pipeline":
+		for _, pipeline := range e.pipelines {
+			add(pipeline.Slug, pipeline.Slug, pipeline.Id, pipeline.PipelineUuid, func(body *hclwrite.Body) {
+				e.writePipeline(body, pipeline)
+			})
+		}
+
+	case "buildkite_pipeline_team":
+		for _, pipeline := range e.pipelines {
+			for _, access := range e.pipelineTeams[pipeline.Id] {
+				add(pipeline.Slug+"_"+access.Team.Slug, pipeline.Slug+"/"+access.Team.Slug, access.Id, "", func(body *hclwrite.Body) {
+					e.setID(body, "pipeline_id", pipeline.Id)
+					e.setID(body, "team_id", access.Team.Id)
+					body.SetAttributeValue("access_level", cty.StringVal(string(access.AccessLevel)))
+				})
+			}
+		}
+
+	case "buildkite_pipeline_schedule":
+		for _, pipeline := range e.pipelines {
+			for _, schedule := range e.schedules[pipeline.Id] {
+				label := schedule.Uuid
+				if schedule.Label != nil {
+					label = *schedule.Label
+				}
+				add(pipeline.Slug+"_"+label, schedule.Id, schedule.Id, schedule.Uuid, func(body *hclwrite.Body) {
+					e.writeSchedule(body, pipeline, schedule)
+				})
+			}
+		}
+
+	case "buildkite_organization_rule":
+		for _, rule := range e.rules {
+			add(e.ruleName(rule), rule.Id, rule.Id, rule.Uuid, func(body *hclwrite.Body) {
+				e.writeRule(body, rule)
+			})
+		}
+
+	case "buildkite_registry":
+		for _, registry := range e.registries {
+			add(registry.Slug, registry.Slug, registry.GraphQLID, registry.ID, func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(registry.Name))
+				body.SetAttributeValue("ecosystem", cty.StringVal(registry.Ecosystem))
+				for _, attribute := range []struct{ name, value string }{
+					{"description", registry.Description},
+					{"emoji", registry.Emoji},
+					{"color", registry.Color},
+					{"oidc_policy", registry.OIDCPolicy},
+				} {
+					if attribute.value != "" {
+						body.SetAttributeRaw(attribute.name, multilineStringTokens(attribute.value))
+					}
+				}
+				if len(registry.TeamIDs) > 0 {
+					teams := make([]hclwrite.Tokens, len(registry.TeamIDs))
+					for i, id := range registry.TeamIDs {
+						teams[i] = e.idTokens(id)
+					}
+					body.SetAttributeRaw("team_ids", hclwrite.TokensForTuple(teams))
+				}
+			})
+		}
+	}
+
+	return resources
+}
+
+func (e *exporter) writePipeline(body *hclwrite.Body, pipeline PipelineFields) {
+	body.SetAttributeValue("name", cty.StringVal(pipeline.Name))
+	body.SetAttributeValue("repository", cty.StringVal(pipeline.Repository.Url))
+	if pipeline.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(pipeline.Description))
+	}
+	if pipeline.DefaultBranch != "" {
+		body.SetAttributeValue("default_branch", cty.StringVal(pipeline.DefaultBranch))
+	}
+	setOptionalString(body, "branch_configuration", pipeline.BranchConfiguration)
+	if pipeline.Cluster.Id != nil {
+		e.setID(body, "cluster_id", *pipeline.Cluster.Id)
+	}
+	setOptionalString(body, "color", pipeline.Color)
+	setOptionalString(body, "emoji", pipeline.Emoji)
+	if pipeline.DefaultTimeoutInMinutes != nil {
+		body.SetAttributeValue("default_timeout_in_minutes", cty.NumberIntVal(int64(*pipeline.DefaultTimeoutInMinutes)))
+	}
+	if pipeline.MaximumTimeoutInMinutes != nil {
+		body.SetAttributeValue("maximum_timeout_in_minutes", cty.NumberIntVal(int64(*pipeline.MaximumTimeoutInMinutes)))
+	}
+	if !pipeline.AllowRebuilds {
+		body.SetAttributeValue("allow_rebuilds", cty.False)
+	}
+	if pipeline.CancelIntermediateBuilds {
+		body.SetAttributeValue("cancel_intermediate_builds", cty.True)
+	}
+	if pipeline.CancelIntermediateBuildsBranchFilter != "" {
+		body.SetAttributeValue("cancel_intermediate_builds_branch_filter", cty.StringVal(pipeline.CancelIntermediateBuildsBranchFilter))
+	}
+	if pipeline.SkipIntermediateBuilds {
+		body.SetAttributeValue("skip_intermediate_builds", cty.True)
+	}
+	if pipeline.SkipIntermediateBuildsBranchFilter != "" {
+		body.SetAttributeValue("skip_intermediate_builds_branch_filter", cty.StringVal(pipeline.SkipIntermediateBuildsBranchFilter))
+	}
+	if len(pipeline.Tags) > 0 {
+		tags := make([]cty.Value, len(pipeline.Tags))
+		for i, tag := range pipeline.Tags {
+			tags[i] = cty.StringVal(tag.Label)
+		}
+		body.SetAttributeValue("tags", cty.SetVal(tags))
+	}
+
+	// as when reading a pipeline, the steps of a templated pipeline come from the template
+	if pipeline.PipelineTemplate.Id != nil {
+		e.setID(body, "pipeline_template_id", *pipeline.PipelineTemplate.Id)
+	} else {
+		body.SetAttributeRaw("steps", multilineStringTokens(pipeline.Steps.Yaml))
+	}
+}
+
+func (e *exporter) writeSchedule(body *hclwrite.Body, pipeline PipelineFields, schedule PipelineScheduleValues) {
+	e.setID(body, "pipeline_id", pipeline.Id)
+	setOptionalString(body, "label", schedule.Label)
+	setOptionalString(body, "cronline", schedule.Cronline)
+	setOptionalString(body, "branch", schedule.Branch)
+	if schedule.Commit != nil && *schedule.Commit != "HEAD" {
+		body.SetAttributeValue("commit", cty.StringVal(*schedule.Commit))
+	}
+	setOptionalString(body, "message", schedule.Message)
+
+	env := make(map[string]cty.Value)
+	for _, variable := range schedule.Env {
+		if variable != nil {
+			key, value, _ := strings.Cut(*variable, "=")
+			env[key] = cty.StringVal(value)
+		}
+	}
+	if len(env) > 0 {
+		body.SetAttributeValue("env", cty.MapVal(env))
+	}
+
+	if !schedule.Enabled {
+		body.SetAttributeValue("enabled", cty.False)
+	}
+}
+
+// writeRule writes rules between two pipelines with their typed attribute, so the pipelines can be referenced, and
+// other rules with their value document
+func (e *exporter) writeRule(body *hclwrite.Body, rule OrganizationRuleFields) {
+	setOptionalString(body, "description", rule.Description)
+
+	var document ruleDocument
+	_ = json.Unmarshal([]byte(rule.Document), &document)
+
+	attribute := ""
+	for name, ruleType := range pipelineRuleTypes {
+		if ruleType == rule.Type {
+			attribute = name
+		}
+	}
+	source, target := ruleSourceTarget(rule)
+
+	var value ruleValue
+	if attribute == "" || source == "" || target == "" || json.Unmarshal(document.Value, &value) != nil {
+		body.SetAttributeValue("type", cty.StringVal(rule.Type))
+		if v, err := obtainValueJSON(rule.Document); err == nil {
+			body.SetAttributeValue("value", cty.StringVal(*v))
+		}
+		return
+	}
+
+	attributes := []hclwrite.ObjectAttrTokens{
+		{Name: hclwrite.TokensForIdentifier("source_pipeline_id"), Value: e.idTokens(source)},
+		{Name: hclwrite.TokensForIdentifier("target_pipeline_id"), Value: e.idTokens(target)},
+	}
+	if len(value.Conditions) > 0 {
+		conditions := make([]cty.Value, len(value.Conditions))
+		for i, condition := range value.Conditions {
+			conditions[i] = cty.StringVal(condition)
+		}
+		attributes = append(attributes, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier("conditions"),
+			Value: hclwrite.TokensForValue(cty.ListVal(conditions)),
+		})
+	}
+	body.SetAttributeRaw(attribute, hclwrite.TokensForObject(attributes))
+}
+
+// ruleName names a rule after the pipelines it applies to when they are known, and its UUID otherwise
+func (e *exporter) ruleName(rule OrganizationRuleFields) string {
+	parts := strings.Split(rule.Type, ".")
+	name := parts[len(parts)/2]
+
+	source, target := ruleSourceTarget(rule)
+	sourceSlug, targetSlug := e.pipelineSlug(source), e.pipelineSlug(target)
+	if sourceSlug == "" || targetSlug == "" {
+		return name + "_" + rule.Uuid
+	}
+	return name + "_" + sourceSlug + "_" + targetSlug
+}
+
+func (e *exporter) pipelineSlug(id string) string {
+	for _, pipeline := range e.pipelines {
+		if pipeline.Id == id {
+			return pipeline.Slug
+		}
+	}
+	return ""
+}
+
+var exportNameInvalidCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// address returns the address of a new resource, deriving a valid and unused resource name from name
+func (e *exporter) address(resourceType, name string) hcl.Traversal {
+	name = strings.Trim(exportNameInvalidCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || !hclsyntax.ValidIdentifier(name) {
+		name = "_" + name
+	}
+
+	if e.names[resourceType] == nil {
+		e.names[resourceType] = make(map[string]bool)
+	}
+	unique := name
+	for i := 2; e.names[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	e.names[resourceType][unique] = true
+
+	return hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: unique}}
+}
+
+// idTokens returns a reference to the attribute of an exported resource holding an ID or UUID, or the ID itself if
+// the object it identifies is not exported
+func (e *exporter) idTokens(id string) hclwrite.Tokens {
+	if ref, ok := e.refs[id]; ok {
+		return hclwrite.TokensForTraversal(ref)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(id))
+}
+
+func (e *exporter) setID(body *hclwrite.Body, name, id string) {
+	body.SetAttributeRaw(name, e.idTokens(id))
+}
+
+func setOptionalString(body *hclwrite.Body, name string, value *string) {
+	if value != nil && *value != "" {
+		body.SetAttributeRaw(name, multilineStringTokens(*value))
+	}
+}
+
+// multilineStringTokens writes strings spanning several lines, such as pipeline steps, as heredocs. Template sequences
+// are escaped so the string is read back unchanged.
+func multilineStringTokens(value string) hclwrite.Tokens {
+	lines := strings.Split(strings.TrimSuffix(value, "\n"), "\n")
+	if !strings.HasSuffix(value, "\n") || len(lines) < 2 || slices.Contains(lines, "EOT") {
+		return hclwrite.TokensForValue(cty.StringVal(value))
+	}
+
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(value)
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<EOT\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte("EOT")},
+	}
+}
+
+// hostedAgentsValue returns the hosted_agents attribute of a queue, setting the platform whose settings are present
+// as when reading the queue
+func hostedAgentsValue(settings HostedAgentsQueueSettingsValues) cty.Value {
+	attributes := map[string]cty.Value{
+		"instance_shape": cty.StringVal(string(settings.InstanceShape.Name)),
+	}
+	if ref := settings.PlatformSettings.Linux.AgentImageRef; ref != "" {
+		attributes["linux"] = cty.ObjectVal(map[string]cty.Value{"agent_image_ref": cty.StringVal(ref)})
+	}
+	if version := settings.PlatformSettings.Macos.XcodeVersion; version != "" {
+		attributes["mac"] = cty.ObjectVal(map[string]cty.Value{"xcode_version": cty.StringVal(version)})
+	}
+	return cty.ObjectVal(attributes)
+}
@@ -0,0 +1,273 @@
+package buildkite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/buildkite/terraform-provider-buildkite/internal/fakebuildkite"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+const exportTestSteps = `steps:
+  - label: ":pipeline: Upload"
+    command: buildkite-agent pipeline upload
+  - trigger: deploy-app
+    build:
+      branch: "${BUILDKITE_BRANCH}"
+`
+
+// exportTestOrganization creates an organization in a fake API with one of each exported resource
+func exportTestOrganization(t *testing.T) (*fakebuildkite.Server, ExportOptions) {
+	t.Helper()
+
+	server := fakebuildkite.NewServer("export-test", "export-token")
+	t.Cleanup(server.Close)
+
+	opts := ExportOptions{
+		Organization: server.Organization,
+		APIToken:     server.Token,
+		GraphqlURL:   server.GraphQLURL(),
+		RestURL:      server.URL,
+		UserAgent:    "testing",
+	}
+	client, err := NewClient(&clientConfig{
+		org:        opts.Organization,
+		apiToken:   opts.APIToken,
+		graphqlURL: opts.GraphqlURL,
+		restURL:    opts.RestURL,
+		userAgent:  opts.UserAgent,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	orgID, err := client.GetOrganizationID()
+	must(err)
+
+	cluster, err := createCluster(ctx, client.genqlient, *orgID, "Deploy", nil, nil, nil)
+	must(err)
+	clusterID := cluster.ClusterCreate.Cluster.Id
+	queue, err := createClusterQueue(ctx, client.genqlient, *orgID, clusterID, "default", nil, nil)
+	must(err)
+	_, err = setClusterDefaultQueue(ctx, client.genqlient, *orgID, clusterID, queue.ClusterQueueCreate.ClusterQueue.Id)
+	must(err)
+
+	team, err := teamCreate(ctx, client.genqlient, *orgID, "Platform", "", "VISIBLE", false, "MEMBER", false)
+	must(err)
+	teamID := team.TeamCreate.TeamEdge.Node.Id
+	userID := server.AddUser("Jane Doe", "jane@example.com")
+	_, err = createTeamMember(ctx, client.genqlient, teamID, userID, "MAINTAINER")
+	must(err)
+
+	_, err = createPipelineTemplate(ctx, client.genqlient, *orgID, "Standard", "steps:\n  - command: make\n", nil, true)
+	must(err)
+
+	web, err := createPipeline(ctx, client.genqlient, PipelineCreateInput{
+		OrganizationId: *orgID,
+		Name:           "Web",
+		Repository:     PipelineRepositoryInput{Url: "git@github.com:example/web.git"},
+		Steps:          PipelineStepsInput{Yaml: exportTestSteps},
+		AllowRebuilds:  true,
+		DefaultBranch:  "main",
+		ClusterId:      &clusterID,
+		Teams:          []PipelineTeamAssignmentInput{{Id: teamID, AccessLevel: PipelineAccessLevelsBuildAndRead}},
+	})
+	must(err)
+	webPipeline := web.PipelineCreate.Pipeline
+	deploy, err := createPipeline(ctx, client.genqlient, PipelineCreateInput{
+		OrganizationId: *orgID,
+		Name:           "Deploy App",
+		Repository:     PipelineRepositoryInput{Url: "git@github.com:example/deploy.git"},
+		Steps:          PipelineStepsInput{Yaml: "steps:\n  - command: deploy\n"},
+		AllowRebuilds:  false,
+	})
+	must(err)
+	deployPipeline := deploy.PipelineCreate.Pipeline
+
+	label, cronline, branch, env := "Nightly", "@midnight", "main", "FOO=bar"
+	_, err = createPipelineSchedule(ctx, client.genqlient, webPipeline.Id, &label, &cronline, nil, nil, &branch, &env, true)
+	must(err)
+
+	value := fmt.Sprintf(`{"source_pipeline": %q, "target_pipeline": %q}`, webPipeline.PipelineUuid, deployPipeline.PipelineUuid)
+	_, err = createOrganizationRule(ctx, client.genqlient, *orgID, nil, "pipeline.trigger_build.pipeline", value)
+	must(err)
+
+	_, err = restSend[registryResponse](ctx, client, http.MethodPost, fmt.Sprintf("/v2/packages/organizations/%s/registries", opts.Organization), map[string]any{
+		"name":      "Gems",
+		"ecosystem": "ruby",
+		"team_ids":  []string{team.TeamCreate.TeamEdge.Node.Uuid},
+	})
+	must(err)
+
+	return server, opts
+}
+
+func runExport(t *testing.T, opts ExportOptions) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	if err := Export(context.Background(), opts, &out); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	if _, diags := hclsyntax.ParseConfig(out.Bytes(), "export.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("export is not valid HCL: %s\n%s", diags.Error(), out.String())
+	}
+	return out.String()
+}
+
+func assertExport(t *testing.T, out string, contains []string, excludes []string) {
+	t.Helper()
+
+	for _, s := range contains {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected export to contain %q", s)
+		}
+	}
+	for _, s := range excludes {
+		if strings.Contains(out, s) {
+			t.Errorf("expected export not to contain %q", s)
+		}
+	}
+	if t.Failed() {
+		t.Log(out)
+	}
+}
+
+func TestExport(t *testing.T) {
+	_, opts := exportTestOrganization(t)
+
+	t.Run("exports every resource with references and import blocks", func(t *testing.T) {
+		out := runExport(t, opts)
+
+		assertExport(t, out, []string{
+			`resource "buildkite_cluster" "deploy" {`,
+			`resource "buildkite_cluster_queue" "deploy_default" {`,
+			`resource "buildkite_cluster_default_queue" "deploy" {`,
+			`queue_id   = buildkite_cluster_queue.deploy_default.id`,
+			`resource "buildkite_team" "platform" {`,
+			`resource "buildkite_team_member" "platform_jane_example_com" {`,
+			`team_id = buildkite_team.platform.id`,
+			`resource "buildkite_pipeline_template" "standard" {`,
+			`resource "buildkite_pipeline" "web" {`,
+			`cluster_id     = buildkite_cluster.deploy.id`,
+			`allow_rebuilds = false`,
+			`resource "buildkite_pipeline_team" "web_platform" {`,
+			`pipeline_id  = buildkite_pipeline.web.id`,
+			`resource "buildkite_pipeline_schedule" "web_nightly" {`,
+			`resource "buildkite_organization_rule" "trigger_build_web_deploy_app" {`,
+			`source_pipeline_id = buildkite_pipeline.web.id`,
+			`target_pipeline_id = buildkite_pipeline.deploy_app.id`,
+			`resource "buildkite_registry" "gems" {`,
+			`team_ids  = [buildkite_team.platform.uuid]`,
+			`to = buildkite_cluster_queue.deploy_default`,
+			`id = "Deploy/default"`,
+			`id = "platform/jane@example.com"`,
+			`id = "web/platform"`,
+			`id = "gems"`,
+		}, nil)
+	})
+
+	t.Run("escapes template sequences in steps", func(t *testing.T) {
+		out := runExport(t, opts)
+
+		file, diags := hclsyntax.ParseConfig([]byte(out), "export.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatal(diags.Error())
+		}
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			if block.Type != "resource" || block.Labels[0] != "buildkite_pipeline" || block.Labels[1] != "web" {
+				continue
+			}
+			steps, diags := block.Body.Attributes["steps"].Expr.Value(nil)
+			if diags.HasErrors() {
+				t.Fatal(diags.Error())
+			}
+			if steps.AsString() != exportTestSteps {
+				t.Errorf("expected steps to read back unchanged, got %q", steps.AsString())
+			}
+			return
+		}
+		t.Fatal("no web pipeline was exported")
+	})
+
+	t.Run("filters by resource type", func(t *testing.T) {
+		opts := opts
+		opts.ResourceTypes = []string{"pipeline", "buildkite_pipeline_team"}
+		out := runExport(t, opts)
+
+		assertExport(t, out, []string{
+			`resource "buildkite_pipeline" "web" {`,
+			`resource "buildkite_pipeline_team" "web_platform" {`,
+			`cluster_id     = "`,
+		}, []string{
+			`resource "buildkite_team"`,
+			`resource "buildkite_cluster"`,
+			`buildkite_team.platform.id`,
+		})
+	})
+
+	t.Run("filters by team", func(t *testing.T) {
+		opts := opts
+		opts.Team = "platform"
+		out := runExport(t, opts)
+
+		assertExport(t, out, []string{
+			`resource "buildkite_team" "platform" {`,
+			`resource "buildkite_pipeline" "web" {`,
+			`resource "buildkite_registry" "gems" {`,
+			`resource "buildkite_organization_rule"`,
+		}, []string{
+			`resource "buildkite_pipeline" "deploy_app"`,
+			`resource "buildkite_cluster"`,
+			`resource "buildkite_pipeline_template"`,
+		})
+	})
+
+	t.Run("filters by cluster", func(t *testing.T) {
+		opts := opts
+		opts.Cluster = "Deploy"
+		out := runExport(t, opts)
+
+		assertExport(t, out, []string{
+			`resource "buildkite_cluster" "deploy" {`,
+			`resource "buildkite_pipeline" "web" {`,
+			`team_id      = "`,
+		}, []string{
+			`resource "buildkite_pipeline" "deploy_app"`,
+			`resource "buildkite_team"`,
+			`resource "buildkite_registry"`,
+		})
+	})
+
+	t.Run("rejects unknown filters", func(t *testing.T) {
+		for _, tc := range []struct {
+			change func(*ExportOptions)
+			err    string
+		}{
+			{func(o *ExportOptions) { o.ResourceTypes = []string{"agent_token"} }, "unable to export resources of type buildkite_agent_token"},
+			{func(o *ExportOptions) { o.Team = "missing" }, `unable to find team with slug "missing"`},
+			{func(o *ExportOptions) { o.Cluster = "missing" }, `unable to find cluster with name "missing"`},
+		} {
+			opts := opts
+			tc.change(&opts)
+			err := Export(context.Background(), opts, &bytes.Buffer{})
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		}
+	})
+}
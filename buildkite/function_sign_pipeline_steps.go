@@ -0,0 +1,113 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+type signPipelineStepsFunction struct{}
+
+func newSignPipelineStepsFunction() function.Function {
+	return &signPipelineStepsFunction{}
+}
+
+func (f *signPipelineStepsFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "sign_pipeline_steps"
+}
+
+func (f *signPipelineStepsFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Sign pipeline steps with a JWKS key",
+		MarkdownDescription: heredoc.Docf(
+			`
+				Signs pipeline steps with a JWKS key in the same way as the %s data source, returning the signed
+				steps in YAML format. As a function, the result can be used directly in the %s attribute of a
+				%s resource and is not re-read on every plan.
+
+				You will need to have the corresponding verification key present on the agents that run the
+				steps in this pipeline. See the Buildkite [documentation](https://buildkite.com/docs/agent/v3/signed_pipelines)
+				for more info about signed pipelines.
+
+				Terraform calls the function again when applying a plan and requires the same result, so only keys
+				with a deterministic signature algorithm can be used: EdDSA, RS256, RS384, RS512, HS256, HS384 or
+				HS512. The ES and PS algorithms produce a new signature each time and are rejected.
+
+				Provider-defined functions require Terraform 1.8 or later.
+			`,
+			"`buildkite_signed_pipeline_steps`",
+			"`steps`",
+			"`buildkite_pipeline`",
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "steps",
+				Description: "The steps to sign in YAML format.",
+			},
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The repository that will be checked out in a build of the pipeline.",
+			},
+			function.StringParameter{
+				Name:        "jwks",
+				Description: "The JSON Web Key Set (JWKS) to use for signing.",
+				MarkdownDescription: heredoc.Docf(
+					`
+						The JSON Web Key Set (JWKS) to use for signing. Use the %s function to read it
+						from a file.
+					`,
+					"`file`",
+				),
+			},
+			function.StringParameter{
+				Name:           "key_id",
+				AllowNullValue: true,
+				Description:    "The ID of the key in the JWKS to use for signing. If this is null, and the JWKS contains exactly one key, that key will be used.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *signPipelineStepsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var steps, repository, jwks string
+	var keyID types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &steps, &repository, &jwks, &keyID))
+	if resp.Error != nil {
+		return
+	}
+
+	// the result must not change between plan and apply
+	key, diags := selectJWKSKey([]byte(jwks), keyID)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	if alg, ok := key.Algorithm().(jwa.SignatureAlgorithm); ok && randomizedSigningAlgorithms[alg] {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf(
+			"The %s signature algorithm produces a different signature each time, so Terraform would find the result "+
+				"inconsistent between plan and apply. Use a key with the EdDSA or RS256 algorithm instead.", alg))
+		return
+	}
+
+	signedSteps, diags := signPipelineSteps(ctx, steps, "steps", repository, []byte(jwks), keyID, stepsInterpolation{})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, signedSteps))
+}
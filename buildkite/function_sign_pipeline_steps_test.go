@@ -0,0 +1,198 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline"
+	"github.com/buildkite/go-pipeline/jwkutil"
+	"github.com/buildkite/go-pipeline/signature"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSignPipelineStepsFunction(t *testing.T) {
+	const (
+		repository = "my-repo"
+		jwksKeyID  = "my-key-id"
+	)
+
+	steps := heredoc.Doc(`
+		steps:
+		- label: ":pipeline:"
+		  command: buildkite-agent pipeline upload
+		env:
+		  GLOBAL_ENV: "foo"
+	`)
+
+	privateJWKS, _, err := jwkutil.NewKeyPair(jwksKeyID, jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	privateKey, _ := privateJWKS.Key(0)
+	jwks, err := json.Marshal(privateJWKS)
+	if err != nil {
+		t.Fatalf("Failed to marshal JWKS: %v", err)
+	}
+
+	p, err := pipeline.Parse(strings.NewReader(steps))
+	if err != nil {
+		t.Fatalf("Failed to parse pipeline: %v", err)
+	}
+	if err := signature.SignSteps(context.Background(), p.Steps, privateKey, repository, signature.WithEnv(p.Env.ToMap())); err != nil {
+		t.Fatalf("Failed to sign pipeline: %v", err)
+	}
+	signedSteps, err := yaml.Marshal(p)
+	if err != nil {
+		t.Fatalf("Failed to marshal signed steps: %v", err)
+	}
+
+	randomizedJWKS, _, err := generateSigningKeyPair(jwa.ES256, jwksKeyID)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	testCases := map[string]struct {
+		steps    string
+		jwks     string
+		keyID    types.String
+		expected string
+		err      string
+	}{
+		"with a key ID": {
+			steps:    steps,
+			keyID:    types.StringValue(jwksKeyID),
+			expected: string(signedSteps),
+		},
+		"without a key ID uses the only key": {
+			steps:    steps,
+			keyID:    types.StringNull(),
+			expected: string(signedSteps),
+		},
+		"with an unknown key ID": {
+			steps: steps,
+			keyID: types.StringValue("other-key"),
+			err:   `Cannot find key: The key with ID "other-key" was not found in the JWKS`,
+		},
+		"with a randomized signature algorithm": {
+			steps: steps,
+			jwks:  string(randomizedJWKS),
+			keyID: types.StringNull(),
+			err:   "The ES256 signature algorithm produces a different signature each time",
+		},
+		"with environment interpolations": {
+			steps: "steps:\n- command: echo $FOO\n",
+			keyID: types.StringNull(),
+			err:   "from the `steps` input: $FOO",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if testCase.jwks == "" {
+				testCase.jwks = string(jwks)
+			}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.steps),
+					types.StringValue(repository),
+					types.StringValue(testCase.jwks),
+					testCase.keyID,
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			newSignPipelineStepsFunction().Run(context.Background(), req, resp)
+
+			if testCase.err != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), testCase.err) {
+					t.Fatalf("expected error containing %q, got %v", testCase.err, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != testCase.expected {
+				t.Errorf("expected signed steps:\n%s\ngot:\n%s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccBuildkiteSignPipelineStepsFunction(t *testing.T) {
+	privateJWKS, _, err := jwkutil.NewKeyPair("my-key-id", jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	jwks, err := json.Marshal(privateJWKS)
+	if err != nil {
+		t.Fatalf("Failed to marshal JWKS: %v", err)
+	}
+
+	t.Run("signed steps can be used directly on a pipeline", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							resource "buildkite_pipeline" "pipeline" {
+							  name       = "%s"
+							  repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							  steps = provider::buildkite::sign_pipeline_steps(
+							    "steps:\n- command: buildkite-agent pipeline upload\n",
+							    "https://github.com/buildkite/terraform-provider-buildkite.git",
+							    %q,
+							    "my-key-id",
+							  )
+							}
+						`,
+						acctest.RandString(12),
+						jwks,
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestMatchResourceAttr("buildkite_pipeline.pipeline", "steps", regexp.MustCompile(`signature:`)),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("environment interpolations are rejected", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							output "steps" {
+							  value = provider::buildkite::sign_pipeline_steps("steps:\n- command: echo $FOO\n", "my-repo", %q, null)
+							}
+						`,
+						jwks,
+					),
+					ExpectError: regexp.MustCompile("Environment interpolations are not allowed"),
+				},
+			},
+		})
+	})
+}
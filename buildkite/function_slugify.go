@@ -0,0 +1,58 @@
+package buildkite
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type slugifyFunction struct{}
+
+func newSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+func (f *slugifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Derive a slug from a name",
+		MarkdownDescription: heredoc.Doc(
+			`
+				Derives a slug from a name in the same way as Buildkite does for pipelines and registries. Letters
+				are lowercased, and each run of any other characters becomes a single hyphen.
+
+				Buildkite adds a suffix to the slug when another pipeline or registry already uses it, so the
+				result is only the slug Buildkite will use when it is unique.
+
+				Provider-defined functions require Terraform 1.8 or later.
+			`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to derive the slug from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, slug.FromName(name)))
+}
@@ -0,0 +1,64 @@
+package buildkite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSlugifyFunction(t *testing.T) {
+	testCases := map[string]string{
+		"My Pipeline":           "my-pipeline",
+		"deploy -- production!": "deploy-production",
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(name)}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			newSlugifyFunction().Run(context.Background(), req, resp)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			if actual := resp.Result.Value().(types.String).ValueString(); actual != expected {
+				t.Errorf("expected %q, got %q", expected, actual)
+			}
+		})
+	}
+}
+
+func TestAccBuildkiteSlugifyFunction(t *testing.T) {
+	t.Run("slug matches the pipeline slug", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "buildkite_pipeline" "pipeline" {
+							name = "Slugify Function Test"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+						}
+
+						output "matches" {
+							value = provider::buildkite::slugify(buildkite_pipeline.pipeline.name) == buildkite_pipeline.pipeline.slug
+						}
+					`,
+					Check: resource.TestCheckOutput("matches", "true"),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package buildkite
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type verifyPipelineStepsFunctionResult struct {
+	Verified types.Bool                    `tfsdk:"verified"`
+	Results  []verifiedPipelineStepsResult `tfsdk:"results"`
+}
+
+type verifyPipelineStepsFunction struct{}
+
+func newVerifyPipelineStepsFunction() function.Function {
+	return &verifyPipelineStepsFunction{}
+}
+
+func (f *verifyPipelineStepsFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "verify_pipeline_steps"
+}
+
+func (f *verifyPipelineStepsFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Verify the signatures of signed pipeline steps with a public JWKS",
+		MarkdownDescription: heredoc.Docf(
+			`
+				Verifies the signature of every command step in the same way as the %s data source. The
+				result is an object with a %s boolean that is true when every command step has a valid
+				signature, and false when there are no command steps, and a %s list with the path, key,
+				label, verification result and error of each command step.
+
+				Provider-defined functions require Terraform 1.8 or later.
+			`,
+			"`buildkite_verified_pipeline_steps`",
+			"`verified`",
+			"`results`",
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "steps",
+				Description: "The signed steps in YAML format.",
+			},
+			function.StringParameter{
+				Name:        "repository",
+				Description: "The repository that will be checked out in a build of the pipeline.",
+			},
+			function.StringParameter{
+				Name:        "jwks",
+				Description: "The public JSON Web Key Set (JWKS) to verify the signatures with.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"verified": types.BoolType,
+				"results": types.ListType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"path":     types.StringType,
+							"key":      types.StringType,
+							"label":    types.StringType,
+							"verified": types.BoolType,
+							"error":    types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (f *verifyPipelineStepsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var steps, repository, jwks string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &steps, &repository, &jwks))
+	if resp.Error != nil {
+		return
+	}
+
+	results, diags := verifyPipelineSteps(ctx, steps, repository, []byte(jwks))
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	var result verifyPipelineStepsFunctionResult
+	result.Verified, result.Results = newVerifiedPipelineStepsResults(results)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,184 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline/jwkutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+func TestVerifyPipelineStepsFunction(t *testing.T) {
+	const repository = "my-repo"
+
+	oldPrivateJWKS, oldPublicJWKS, err := jwkutil.NewKeyPair("old-key", jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	_, newPublicJWKS, err := jwkutil.NewKeyPair("new-key", jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	oldPrivateKeys, _ := json.Marshal(oldPrivateJWKS)
+	oldPublicKeys, _ := json.Marshal(oldPublicJWKS)
+	newPublicKeys, _ := json.Marshal(newPublicJWKS)
+
+	signedSteps, diags := signPipelineSteps(
+		context.Background(),
+		"steps:\n- command: buildkite-agent pipeline upload\n",
+		"steps",
+		repository,
+		oldPrivateKeys,
+		types.StringNull(),
+		stepsInterpolation{},
+	)
+	if diags.HasError() {
+		t.Fatalf("Failed to sign steps: %v", diags)
+	}
+
+	testCases := map[string]struct {
+		jwks       []byte
+		repository string
+		verified   bool
+	}{
+		"signed with a key in the set": {
+			jwks:       oldPublicKeys,
+			repository: repository,
+			verified:   true,
+		},
+		"signed with a key that was rotated out": {
+			jwks:       newPublicKeys,
+			repository: repository,
+			verified:   false,
+		},
+		"signed for another repository": {
+			jwks:       oldPublicKeys,
+			repository: "other-repo",
+			verified:   false,
+		},
+	}
+
+	definition := &function.DefinitionResponse{}
+	newVerifyPipelineStepsFunction().Definition(context.Background(), function.DefinitionRequest{}, definition)
+	returnTypes := definition.Definition.Return.(function.ObjectReturn).AttributeTypes
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(signedSteps),
+					types.StringValue(testCase.repository),
+					types.StringValue(string(testCase.jwks)),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(returnTypes))}
+
+			newVerifyPipelineStepsFunction().Run(context.Background(), req, resp)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			result := resp.Result.Value().(basetypes.ObjectValue).Attributes()
+			if verified := result["verified"].(types.Bool).ValueBool(); verified != testCase.verified {
+				t.Errorf("expected verified to be %v, got %v", testCase.verified, verified)
+			}
+			if steps := result["results"].(types.List).Elements(); len(steps) != 1 {
+				t.Errorf("expected a result for 1 step, got %d", len(steps))
+			}
+		})
+	}
+
+	t.Run("no command steps", func(t *testing.T) {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue("steps:\n- wait\n"),
+				types.StringValue(repository),
+				types.StringValue(string(oldPublicKeys)),
+			}),
+		}
+		resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(returnTypes))}
+
+		newVerifyPipelineStepsFunction().Run(context.Background(), req, resp)
+		if resp.Error != nil {
+			t.Fatalf("unexpected error: %v", resp.Error)
+		}
+
+		result := resp.Result.Value().(basetypes.ObjectValue).Attributes()
+		if result["verified"].(types.Bool).ValueBool() {
+			t.Error("expected steps without command steps not to be verified")
+		}
+		if steps := result["results"].(types.List).Elements(); len(steps) != 0 {
+			t.Errorf("expected no results, got %d", len(steps))
+		}
+	})
+}
+
+func TestAccBuildkiteVerifyPipelineStepsFunction(t *testing.T) {
+	privateJWKS, publicJWKS, err := jwkutil.NewKeyPair("my-key-id", jwa.EdDSA)
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	privateKeys, _ := json.Marshal(privateJWKS)
+	publicKeys, _ := json.Marshal(publicJWKS)
+
+	t.Run("steps signed by the provider verify with the public key", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							locals {
+							  signed = provider::buildkite::sign_pipeline_steps("steps:\n- command: make test\n", "my-repo", %q, null)
+							}
+
+							output "verified" {
+							  value = provider::buildkite::verify_pipeline_steps(local.signed, "my-repo", %q).verified
+							}
+						`,
+						privateKeys,
+						publicKeys,
+					),
+					Check: resource.TestCheckOutput("verified", "true"),
+				},
+			},
+		})
+	})
+
+	t.Run("invalid steps fail", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: heredoc.Docf(
+						`
+							output "verified" {
+							  value = provider::buildkite::verify_pipeline_steps("steps: 1", "my-repo", %q).verified
+							}
+						`,
+						publicKeys,
+					),
+					ExpectError: regexp.MustCompile("Unable to parse pipeline steps"),
+				},
+			},
+		})
+	})
+}
@@ -115,6 +115,43 @@ func (v *ClusterFieldsDefaultQueueClusterQueue) GetKey() string { return v.Key }
 // GetDescription returns ClusterFieldsDefaultQueueClusterQueue.Description, and is useful for accessing the field via an interface.
 func (v *ClusterFieldsDefaultQueueClusterQueue) GetDescription() *string { return v.Description }
 
+// ClusterQueueDispatchValues includes the GraphQL fields of ClusterQueue requested by the fragment ClusterQueueDispatchValues.
+type ClusterQueueDispatchValues struct {
+	// States whether job dispatch is paused for this cluster queue
+	DispatchPaused bool `json:"dispatchPaused"`
+	// The time this queue was paused
+	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
+	// The user who paused this cluster queue
+	DispatchPausedBy *ClusterQueueDispatchValuesDispatchPausedByUser `json:"dispatchPausedBy"`
+	// Note describing why job dispatch was paused for this cluster queue
+	DispatchPausedNote *string `json:"dispatchPausedNote"`
+}
+
+// GetDispatchPaused returns ClusterQueueDispatchValues.DispatchPaused, and is useful for accessing the field via an interface.
+func (v *ClusterQueueDispatchValues) GetDispatchPaused() bool { return v.DispatchPaused }
+
+// GetDispatchPausedAt returns ClusterQueueDispatchValues.DispatchPausedAt, and is useful for accessing the field via an interface.
+func (v *ClusterQueueDispatchValues) GetDispatchPausedAt() *time.Time { return v.DispatchPausedAt }
+
+// GetDispatchPausedBy returns ClusterQueueDispatchValues.DispatchPausedBy, and is useful for accessing the field via an interface.
+func (v *ClusterQueueDispatchValues) GetDispatchPausedBy() *ClusterQueueDispatchValuesDispatchPausedByUser {
+	return v.DispatchPausedBy
+}
+
+// GetDispatchPausedNote returns ClusterQueueDispatchValues.DispatchPausedNote, and is useful for accessing the field via an interface.
+func (v *ClusterQueueDispatchValues) GetDispatchPausedNote() *string { return v.DispatchPausedNote }
+
+// ClusterQueueDispatchValuesDispatchPausedByUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user
+type ClusterQueueDispatchValuesDispatchPausedByUser struct {
+	Id string `json:"id"`
+}
+
+// GetId returns ClusterQueueDispatchValuesDispatchPausedByUser.Id, and is useful for accessing the field via an interface.
+func (v *ClusterQueueDispatchValuesDispatchPausedByUser) GetId() string { return v.Id }
+
 // ClusterQueueValues includes the GraphQL fields of ClusterQueue requested by the fragment ClusterQueueValues.
 type ClusterQueueValues struct {
 	Id string `json:"id"`
@@ -1038,6 +1075,7 @@ func (v *OrganizationRuleFields) __premarshalJSON() (*__premarshalOrganizationRu
 // A pipeline
 type OrganizationRuleFieldsSourcePipeline struct {
 	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 	// The UUID of the pipeline
 	Uuid string `json:"uuid"`
 }
@@ -1045,6 +1083,9 @@ type OrganizationRuleFieldsSourcePipeline struct {
 // GetTypename returns OrganizationRuleFieldsSourcePipeline.Typename, and is useful for accessing the field via an interface.
 func (v *OrganizationRuleFieldsSourcePipeline) GetTypename() string { return v.Typename }
 
+// GetId returns OrganizationRuleFieldsSourcePipeline.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationRuleFieldsSourcePipeline) GetId() string { return v.Id }
+
 // GetUuid returns OrganizationRuleFieldsSourcePipeline.Uuid, and is useful for accessing the field via an interface.
 func (v *OrganizationRuleFieldsSourcePipeline) GetUuid() string { return v.Uuid }
 
@@ -1116,6 +1157,7 @@ func __marshalOrganizationRuleFieldsSourceRuleSource(v *OrganizationRuleFieldsSo
 // A pipeline
 type OrganizationRuleFieldsTargetPipeline struct {
 	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 	// The UUID of the pipeline
 	Uuid string `json:"uuid"`
 }
@@ -1123,6 +1165,9 @@ type OrganizationRuleFieldsTargetPipeline struct {
 // GetTypename returns OrganizationRuleFieldsTargetPipeline.Typename, and is useful for accessing the field via an interface.
 func (v *OrganizationRuleFieldsTargetPipeline) GetTypename() string { return v.Typename }
 
+// GetId returns OrganizationRuleFieldsTargetPipeline.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationRuleFieldsTargetPipeline) GetId() string { return v.Id }
+
 // GetUuid returns OrganizationRuleFieldsTargetPipeline.Uuid, and is useful for accessing the field via an interface.
 func (v *OrganizationRuleFieldsTargetPipeline) GetUuid() string { return v.Uuid }
 
@@ -1788,11 +1833,16 @@ func (v *PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline) GetTeam() PipelineTe
 // An organization team
 type PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipelineTeam struct {
 	Id string `json:"id"`
+	// The slug of the team
+	Slug string `json:"slug"`
 }
 
 // GetId returns PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipelineTeam.Id, and is useful for accessing the field via an interface.
 func (v *PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipelineTeam) GetId() string { return v.Id }
 
+// GetSlug returns PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipelineTeam.Slug, and is useful for accessing the field via an interface.
+func (v *PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipelineTeam) GetSlug() string { return v.Slug }
+
 // PipelineTeamPageInfo includes the requested fields of the GraphQL type PageInfo.
 // The GraphQL type's documentation follows.
 //
@@ -2605,6 +2655,26 @@ type __getAgentTokenInput struct {
 // GetSlug returns __getAgentTokenInput.Slug, and is useful for accessing the field via an interface.
 func (v *__getAgentTokenInput) GetSlug() string { return v.Slug }
 
+// __getAgentTokenValueInput is used internally by genqlient
+type __getAgentTokenValueInput struct {
+	Slug string `json:"slug"`
+}
+
+// GetSlug returns __getAgentTokenValueInput.Slug, and is useful for accessing the field via an interface.
+func (v *__getAgentTokenValueInput) GetSlug() string { return v.Slug }
+
+// __getClusterAgentTokenValuesInput is used internally by genqlient
+type __getClusterAgentTokenValuesInput struct {
+	OrgSlug string `json:"orgSlug"`
+	Id      string `json:"id"`
+}
+
+// GetOrgSlug returns __getClusterAgentTokenValuesInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getClusterAgentTokenValuesInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetId returns __getClusterAgentTokenValuesInput.Id, and is useful for accessing the field via an interface.
+func (v *__getClusterAgentTokenValuesInput) GetId() string { return v.Id }
+
 // __getClusterAgentTokensInput is used internally by genqlient
 type __getClusterAgentTokensInput struct {
 	OrgSlug string `json:"orgSlug"`
@@ -2629,6 +2699,46 @@ func (v *__getClusterByNameInput) GetOrgSlug() string { return v.OrgSlug }
 // GetCursor returns __getClusterByNameInput.Cursor, and is useful for accessing the field via an interface.
 func (v *__getClusterByNameInput) GetCursor() *string { return v.Cursor }
 
+// __getClusterQueueAgentsInput is used internally by genqlient
+type __getClusterQueueAgentsInput struct {
+	OrgSlug string  `json:"orgSlug"`
+	QueueId string  `json:"queueId"`
+	Cursor  *string `json:"cursor"`
+}
+
+// GetOrgSlug returns __getClusterQueueAgentsInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueAgentsInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetQueueId returns __getClusterQueueAgentsInput.QueueId, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueAgentsInput) GetQueueId() string { return v.QueueId }
+
+// GetCursor returns __getClusterQueueAgentsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueAgentsInput) GetCursor() *string { return v.Cursor }
+
+// __getClusterQueueRunningJobsInput is used internally by genqlient
+type __getClusterQueueRunningJobsInput struct {
+	OrgSlug string `json:"orgSlug"`
+	QueueId string `json:"queueId"`
+}
+
+// GetOrgSlug returns __getClusterQueueRunningJobsInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueRunningJobsInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetQueueId returns __getClusterQueueRunningJobsInput.QueueId, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueRunningJobsInput) GetQueueId() string { return v.QueueId }
+
+// __getClusterQueueScheduledJobsInput is used internally by genqlient
+type __getClusterQueueScheduledJobsInput struct {
+	OrgSlug string `json:"orgSlug"`
+	QueueId string `json:"queueId"`
+}
+
+// GetOrgSlug returns __getClusterQueueScheduledJobsInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueScheduledJobsInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetQueueId returns __getClusterQueueScheduledJobsInput.QueueId, and is useful for accessing the field via an interface.
+func (v *__getClusterQueueScheduledJobsInput) GetQueueId() string { return v.QueueId }
+
 // __getClusterQueuesInput is used internally by genqlient
 type __getClusterQueuesInput struct {
 	OrgSlug string  `json:"orgSlug"`
@@ -2661,6 +2771,18 @@ type __getOrganizationInput struct {
 // GetSlug returns __getOrganizationInput.Slug, and is useful for accessing the field via an interface.
 func (v *__getOrganizationInput) GetSlug() string { return v.Slug }
 
+// __getOrganizationPipelinesInput is used internally by genqlient
+type __getOrganizationPipelinesInput struct {
+	OrgSlug string  `json:"orgSlug"`
+	Cursor  *string `json:"cursor"`
+}
+
+// GetOrgSlug returns __getOrganizationPipelinesInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getOrganizationPipelinesInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetCursor returns __getOrganizationPipelinesInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationPipelinesInput) GetCursor() *string { return v.Cursor }
+
 // __getOrganizationRuleInput is used internally by genqlient
 type __getOrganizationRuleInput struct {
 	Uuid string `json:"uuid"`
@@ -2669,6 +2791,30 @@ type __getOrganizationRuleInput struct {
 // GetUuid returns __getOrganizationRuleInput.Uuid, and is useful for accessing the field via an interface.
 func (v *__getOrganizationRuleInput) GetUuid() string { return v.Uuid }
 
+// __getOrganizationRulesInput is used internally by genqlient
+type __getOrganizationRulesInput struct {
+	OrgSlug string  `json:"orgSlug"`
+	Cursor  *string `json:"cursor"`
+}
+
+// GetOrgSlug returns __getOrganizationRulesInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getOrganizationRulesInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetCursor returns __getOrganizationRulesInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationRulesInput) GetCursor() *string { return v.Cursor }
+
+// __getOrganizationTeamsInput is used internally by genqlient
+type __getOrganizationTeamsInput struct {
+	OrgSlug string  `json:"orgSlug"`
+	Cursor  *string `json:"cursor"`
+}
+
+// GetOrgSlug returns __getOrganizationTeamsInput.OrgSlug, and is useful for accessing the field via an interface.
+func (v *__getOrganizationTeamsInput) GetOrgSlug() string { return v.OrgSlug }
+
+// GetCursor returns __getOrganizationTeamsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOrganizationTeamsInput) GetCursor() *string { return v.Cursor }
+
 // __getOrganiztionBannerInput is used internally by genqlient
 type __getOrganiztionBannerInput struct {
 	OrgSlug string `json:"orgSlug"`
@@ -2701,6 +2847,14 @@ type __getPipelineScheduleInput struct {
 // GetId returns __getPipelineScheduleInput.Id, and is useful for accessing the field via an interface.
 func (v *__getPipelineScheduleInput) GetId() string { return v.Id }
 
+// __getPipelineSchedulesInput is used internally by genqlient
+type __getPipelineSchedulesInput struct {
+	Slug string `json:"slug"`
+}
+
+// GetSlug returns __getPipelineSchedulesInput.Slug, and is useful for accessing the field via an interface.
+func (v *__getPipelineSchedulesInput) GetSlug() string { return v.Slug }
+
 // __getPipelineTeamsInput is used internally by genqlient
 type __getPipelineTeamsInput struct {
 	Slug   string `json:"slug"`
@@ -2725,6 +2879,18 @@ func (v *__getPipelineTemplatesInput) GetOrgSlug() string { return v.OrgSlug }
 // GetCursor returns __getPipelineTemplatesInput.Cursor, and is useful for accessing the field via an interface.
 func (v *__getPipelineTemplatesInput) GetCursor() *string { return v.Cursor }
 
+// __getTeamMembersInput is used internally by genqlient
+type __getTeamMembersInput struct {
+	Slug   string  `json:"slug"`
+	Cursor *string `json:"cursor"`
+}
+
+// GetSlug returns __getTeamMembersInput.Slug, and is useful for accessing the field via an interface.
+func (v *__getTeamMembersInput) GetSlug() string { return v.Slug }
+
+// GetCursor returns __getTeamMembersInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getTeamMembersInput) GetCursor() *string { return v.Cursor }
+
 // __getTestSuiteInput is used internally by genqlient
 type __getTestSuiteInput struct {
 	Id        string `json:"id"`
@@ -2739,12 +2905,16 @@ func (v *__getTestSuiteInput) GetTeamCount() int { return v.TeamCount }
 
 // __pauseDispatchClusterQueueInput is used internally by genqlient
 type __pauseDispatchClusterQueueInput struct {
-	Id string `json:"id"`
+	Id   string  `json:"id"`
+	Note *string `json:"note"`
 }
 
 // GetId returns __pauseDispatchClusterQueueInput.Id, and is useful for accessing the field via an interface.
 func (v *__pauseDispatchClusterQueueInput) GetId() string { return v.Id }
 
+// GetNote returns __pauseDispatchClusterQueueInput.Note, and is useful for accessing the field via an interface.
+func (v *__pauseDispatchClusterQueueInput) GetNote() *string { return v.Note }
+
 // __removeClusterDefaultQueueInput is used internally by genqlient
 type __removeClusterDefaultQueueInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -2829,6 +2999,14 @@ func (v *__setOrganization2FAInput) GetOrganizationID() string { return v.Organi
 // GetValue returns __setOrganization2FAInput.Value, and is useful for accessing the field via an interface.
 func (v *__setOrganization2FAInput) GetValue() bool { return v.Value }
 
+// __stopAgentInput is used internally by genqlient
+type __stopAgentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __stopAgentInput.Id, and is useful for accessing the field via an interface.
+func (v *__stopAgentInput) GetId() string { return v.Id }
+
 // __teamCreateInput is used internally by genqlient
 type __teamCreateInput struct {
 	OrganizationID            string `json:"organizationID"`
@@ -5040,6 +5218,203 @@ type getAgentTokenResponse struct {
 // GetAgentToken returns getAgentTokenResponse.AgentToken, and is useful for accessing the field via an interface.
 func (v *getAgentTokenResponse) GetAgentToken() getAgentTokenAgentToken { return v.AgentToken }
 
+// getAgentTokenValueAgentToken includes the requested fields of the GraphQL type AgentToken.
+// The GraphQL type's documentation follows.
+//
+// A token used to connect an agent to Buildkite
+type getAgentTokenValueAgentToken struct {
+	Id string `json:"id"`
+	// A description about what this agent token is used for
+	Description *string `json:"description"`
+	// The public UUID for the agent
+	Uuid string `json:"uuid"`
+	// The token value used to register a new agent
+	Token string `json:"token"`
+	// The time this agent token was revoked
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+// GetId returns getAgentTokenValueAgentToken.Id, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueAgentToken) GetId() string { return v.Id }
+
+// GetDescription returns getAgentTokenValueAgentToken.Description, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueAgentToken) GetDescription() *string { return v.Description }
+
+// GetUuid returns getAgentTokenValueAgentToken.Uuid, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueAgentToken) GetUuid() string { return v.Uuid }
+
+// GetToken returns getAgentTokenValueAgentToken.Token, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueAgentToken) GetToken() string { return v.Token }
+
+// GetRevokedAt returns getAgentTokenValueAgentToken.RevokedAt, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueAgentToken) GetRevokedAt() *time.Time { return v.RevokedAt }
+
+// getAgentTokenValueResponse is returned by getAgentTokenValue on success.
+type getAgentTokenValueResponse struct {
+	// Find an agent token by its slug
+	AgentToken getAgentTokenValueAgentToken `json:"agentToken"`
+}
+
+// GetAgentToken returns getAgentTokenValueResponse.AgentToken, and is useful for accessing the field via an interface.
+func (v *getAgentTokenValueResponse) GetAgentToken() getAgentTokenValueAgentToken {
+	return v.AgentToken
+}
+
+// getClusterAgentTokenValuesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getClusterAgentTokenValuesOrganization struct {
+	// Return cluster in the Organization by UUID
+	Cluster getClusterAgentTokenValuesOrganizationCluster `json:"cluster"`
+}
+
+// GetCluster returns getClusterAgentTokenValuesOrganization.Cluster, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganization) GetCluster() getClusterAgentTokenValuesOrganizationCluster {
+	return v.Cluster
+}
+
+// getClusterAgentTokenValuesOrganizationCluster includes the requested fields of the GraphQL type Cluster.
+type getClusterAgentTokenValuesOrganizationCluster struct {
+	// Returns agent tokens for the Cluster
+	AgentTokens getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection `json:"agentTokens"`
+}
+
+// GetAgentTokens returns getClusterAgentTokenValuesOrganizationCluster.AgentTokens, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationCluster) GetAgentTokens() getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection {
+	return v.AgentTokens
+}
+
+// getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection includes the requested fields of the GraphQL type ClusterAgentTokenConnection.
+type getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection struct {
+	Edges []getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge `json:"edges"`
+}
+
+// GetEdges returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnection) GetEdges() []getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge {
+	return v.Edges
+}
+
+// getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge includes the requested fields of the GraphQL type ClusterAgentTokenEdge.
+type getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge struct {
+	Node getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken `json:"node"`
+}
+
+// GetNode returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge.Node, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdge) GetNode() getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken {
+	return v.Node
+}
+
+// getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken includes the requested fields of the GraphQL type ClusterToken.
+// The GraphQL type's documentation follows.
+//
+// A token used to connect an agent in cluster to Buildkite
+type getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken struct {
+	ClusterAgentTokenValues `json:"-"`
+	// The token value used to register a new agent to this tokens cluster. This will soon return an empty string before we finally remove this field.
+	Token string `json:"token"`
+}
+
+// GetToken returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.Token, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetToken() string {
+	return v.Token
+}
+
+// GetAllowedIpAddresses returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.AllowedIpAddresses, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetAllowedIpAddresses() string {
+	return v.ClusterAgentTokenValues.AllowedIpAddresses
+}
+
+// GetCluster returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.Cluster, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetCluster() ClusterAgentTokenValuesCluster {
+	return v.ClusterAgentTokenValues.Cluster
+}
+
+// GetDescription returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.Description, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetDescription() string {
+	return v.ClusterAgentTokenValues.Description
+}
+
+// GetId returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.Id, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetId() string {
+	return v.ClusterAgentTokenValues.Id
+}
+
+// GetUuid returns getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken.Uuid, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) GetUuid() string {
+	return v.ClusterAgentTokenValues.Uuid
+}
+
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ClusterAgentTokenValues)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken struct {
+	Token string `json:"token"`
+
+	AllowedIpAddresses string `json:"allowedIpAddresses"`
+
+	Cluster ClusterAgentTokenValuesCluster `json:"cluster"`
+
+	Description string `json:"description"`
+
+	Id string `json:"id"`
+
+	Uuid string `json:"uuid"`
+}
+
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken) __premarshalJSON() (*__premarshalgetClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken, error) {
+	var retval __premarshalgetClusterAgentTokenValuesOrganizationClusterAgentTokensClusterAgentTokenConnectionEdgesClusterAgentTokenEdgeNodeClusterToken
+
+	retval.Token = v.Token
+	retval.AllowedIpAddresses = v.ClusterAgentTokenValues.AllowedIpAddresses
+	retval.Cluster = v.ClusterAgentTokenValues.Cluster
+	retval.Description = v.ClusterAgentTokenValues.Description
+	retval.Id = v.ClusterAgentTokenValues.Id
+	retval.Uuid = v.ClusterAgentTokenValues.Uuid
+	return &retval, nil
+}
+
+// getClusterAgentTokenValuesResponse is returned by getClusterAgentTokenValues on success.
+type getClusterAgentTokenValuesResponse struct {
+	// Find an organization
+	Organization getClusterAgentTokenValuesOrganization `json:"organization"`
+}
+
+// GetOrganization returns getClusterAgentTokenValuesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getClusterAgentTokenValuesResponse) GetOrganization() getClusterAgentTokenValuesOrganization {
+	return v.Organization
+}
+
 // getClusterAgentTokensOrganization includes the requested fields of the GraphQL type Organization.
 // The GraphQL type's documentation follows.
 //
@@ -5359,87 +5734,222 @@ func (v *getClusterByNameResponse) GetOrganization() getClusterByNameOrganizatio
 	return v.Organization
 }
 
-// getClusterQueuesOrganization includes the requested fields of the GraphQL type Organization.
+// getClusterQueueAgentsOrganization includes the requested fields of the GraphQL type Organization.
 // The GraphQL type's documentation follows.
 //
 // An organization
-type getClusterQueuesOrganization struct {
-	// Return cluster in the Organization by UUID
-	Cluster getClusterQueuesOrganizationCluster `json:"cluster"`
+type getClusterQueueAgentsOrganization struct {
+	Agents getClusterQueueAgentsOrganizationAgentsAgentConnection `json:"agents"`
 }
 
-// GetCluster returns getClusterQueuesOrganization.Cluster, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganization) GetCluster() getClusterQueuesOrganizationCluster {
-	return v.Cluster
+// GetAgents returns getClusterQueueAgentsOrganization.Agents, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganization) GetAgents() getClusterQueueAgentsOrganizationAgentsAgentConnection {
+	return v.Agents
 }
 
-// getClusterQueuesOrganizationCluster includes the requested fields of the GraphQL type Cluster.
-type getClusterQueuesOrganizationCluster struct {
-	Queues getClusterQueuesOrganizationClusterQueuesClusterQueueConnection `json:"queues"`
+// getClusterQueueAgentsOrganizationAgentsAgentConnection includes the requested fields of the GraphQL type AgentConnection.
+type getClusterQueueAgentsOrganizationAgentsAgentConnection struct {
+	PageInfo getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo         `json:"pageInfo"`
+	Edges    []getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge `json:"edges"`
 }
 
-// GetQueues returns getClusterQueuesOrganizationCluster.Queues, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationCluster) GetQueues() getClusterQueuesOrganizationClusterQueuesClusterQueueConnection {
-	return v.Queues
+// GetPageInfo returns getClusterQueueAgentsOrganizationAgentsAgentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnection) GetPageInfo() getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo {
+	return v.PageInfo
 }
 
-// getClusterQueuesOrganizationClusterQueuesClusterQueueConnection includes the requested fields of the GraphQL type ClusterQueueConnection.
-type getClusterQueuesOrganizationClusterQueuesClusterQueueConnection struct {
-	PageInfo getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionPageInfo                `json:"pageInfo"`
-	Edges    []getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge `json:"edges"`
+// GetEdges returns getClusterQueueAgentsOrganizationAgentsAgentConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnection) GetEdges() []getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge {
+	return v.Edges
 }
 
-// GetPageInfo returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnection) GetPageInfo() getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionPageInfo {
-	return v.PageInfo
+// getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge includes the requested fields of the GraphQL type AgentEdge.
+type getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge struct {
+	Node getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent `json:"node"`
 }
 
-// GetEdges returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnection) GetEdges() []getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge {
-	return v.Edges
+// GetNode returns getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge.Node, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdge) GetNode() getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent {
+	return v.Node
 }
 
-// getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge includes the requested fields of the GraphQL type ClusterQueueEdge.
-type getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge struct {
-	Node getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue `json:"node"`
+// getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent includes the requested fields of the GraphQL type Agent.
+// The GraphQL type's documentation follows.
+//
+// An agent
+type getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent struct {
+	Id string `json:"id"`
+	// The name of the agent
+	Name string `json:"name"`
+	// The connection state of the agent
+	ConnectionState string `json:"connectionState"`
 }
 
-// GetNode returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge.Node, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge) GetNode() getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue {
-	return v.Node
+// GetId returns getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent.Id, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent) GetId() string {
+	return v.Id
 }
 
-// getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue includes the requested fields of the GraphQL type ClusterQueue.
-type getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue struct {
-	ClusterQueueValues `json:"-"`
-	// States whether job dispatch is paused for this cluster queue
-	DispatchPaused bool `json:"dispatchPaused"`
-	// The time this queue was paused
-	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
-	// The user who paused this cluster queue
-	DispatchPausedBy *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser `json:"dispatchPausedBy"`
-	// Note describing why job dispatch was paused for this cluster queue
-	DispatchPausedNote *string `json:"dispatchPausedNote"`
+// GetName returns getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent.Name, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent) GetName() string {
+	return v.Name
 }
 
-// GetDispatchPaused returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPaused, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPaused() bool {
-	return v.DispatchPaused
+// GetConnectionState returns getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent.ConnectionState, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionEdgesAgentEdgeNodeAgent) GetConnectionState() string {
+	return v.ConnectionState
 }
 
-// GetDispatchPausedAt returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedAt, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedAt() *time.Time {
-	return v.DispatchPausedAt
+// getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
 }
 
-// GetDispatchPausedBy returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedBy, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedBy() *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser {
-	return v.DispatchPausedBy
+// GetEndCursor returns getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
 }
 
-// GetDispatchPausedNote returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedNote, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedNote() *string {
-	return v.DispatchPausedNote
+// GetHasNextPage returns getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsOrganizationAgentsAgentConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// getClusterQueueAgentsResponse is returned by getClusterQueueAgents on success.
+type getClusterQueueAgentsResponse struct {
+	// Find an organization
+	Organization getClusterQueueAgentsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getClusterQueueAgentsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getClusterQueueAgentsResponse) GetOrganization() getClusterQueueAgentsOrganization {
+	return v.Organization
+}
+
+// getClusterQueueRunningJobsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getClusterQueueRunningJobsOrganization struct {
+	Jobs getClusterQueueRunningJobsOrganizationJobsJobConnection `json:"jobs"`
+}
+
+// GetJobs returns getClusterQueueRunningJobsOrganization.Jobs, and is useful for accessing the field via an interface.
+func (v *getClusterQueueRunningJobsOrganization) GetJobs() getClusterQueueRunningJobsOrganizationJobsJobConnection {
+	return v.Jobs
+}
+
+// getClusterQueueRunningJobsOrganizationJobsJobConnection includes the requested fields of the GraphQL type JobConnection.
+type getClusterQueueRunningJobsOrganizationJobsJobConnection struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns getClusterQueueRunningJobsOrganizationJobsJobConnection.Count, and is useful for accessing the field via an interface.
+func (v *getClusterQueueRunningJobsOrganizationJobsJobConnection) GetCount() int { return v.Count }
+
+// getClusterQueueRunningJobsResponse is returned by getClusterQueueRunningJobs on success.
+type getClusterQueueRunningJobsResponse struct {
+	// Find an organization
+	Organization getClusterQueueRunningJobsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getClusterQueueRunningJobsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getClusterQueueRunningJobsResponse) GetOrganization() getClusterQueueRunningJobsOrganization {
+	return v.Organization
+}
+
+// getClusterQueueScheduledJobsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getClusterQueueScheduledJobsOrganization struct {
+	Jobs getClusterQueueScheduledJobsOrganizationJobsJobConnection `json:"jobs"`
+}
+
+// GetJobs returns getClusterQueueScheduledJobsOrganization.Jobs, and is useful for accessing the field via an interface.
+func (v *getClusterQueueScheduledJobsOrganization) GetJobs() getClusterQueueScheduledJobsOrganizationJobsJobConnection {
+	return v.Jobs
+}
+
+// getClusterQueueScheduledJobsOrganizationJobsJobConnection includes the requested fields of the GraphQL type JobConnection.
+type getClusterQueueScheduledJobsOrganizationJobsJobConnection struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns getClusterQueueScheduledJobsOrganizationJobsJobConnection.Count, and is useful for accessing the field via an interface.
+func (v *getClusterQueueScheduledJobsOrganizationJobsJobConnection) GetCount() int { return v.Count }
+
+// getClusterQueueScheduledJobsResponse is returned by getClusterQueueScheduledJobs on success.
+type getClusterQueueScheduledJobsResponse struct {
+	// Find an organization
+	Organization getClusterQueueScheduledJobsOrganization `json:"organization"`
+}
+
+// GetOrganization returns getClusterQueueScheduledJobsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getClusterQueueScheduledJobsResponse) GetOrganization() getClusterQueueScheduledJobsOrganization {
+	return v.Organization
+}
+
+// getClusterQueuesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getClusterQueuesOrganization struct {
+	// Return cluster in the Organization by UUID
+	Cluster getClusterQueuesOrganizationCluster `json:"cluster"`
+}
+
+// GetCluster returns getClusterQueuesOrganization.Cluster, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganization) GetCluster() getClusterQueuesOrganizationCluster {
+	return v.Cluster
+}
+
+// getClusterQueuesOrganizationCluster includes the requested fields of the GraphQL type Cluster.
+type getClusterQueuesOrganizationCluster struct {
+	Queues getClusterQueuesOrganizationClusterQueuesClusterQueueConnection `json:"queues"`
+}
+
+// GetQueues returns getClusterQueuesOrganizationCluster.Queues, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationCluster) GetQueues() getClusterQueuesOrganizationClusterQueuesClusterQueueConnection {
+	return v.Queues
+}
+
+// getClusterQueuesOrganizationClusterQueuesClusterQueueConnection includes the requested fields of the GraphQL type ClusterQueueConnection.
+type getClusterQueuesOrganizationClusterQueuesClusterQueueConnection struct {
+	PageInfo getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionPageInfo                `json:"pageInfo"`
+	Edges    []getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge `json:"edges"`
+}
+
+// GetPageInfo returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnection) GetPageInfo() getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnection) GetEdges() []getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge {
+	return v.Edges
+}
+
+// getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge includes the requested fields of the GraphQL type ClusterQueueEdge.
+type getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge struct {
+	Node getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue `json:"node"`
+}
+
+// GetNode returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge.Node, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdge) GetNode() getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue {
+	return v.Node
+}
+
+// getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue includes the requested fields of the GraphQL type ClusterQueue.
+type getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue struct {
+	ClusterQueueValues         `json:"-"`
+	ClusterQueueDispatchValues `json:"-"`
 }
 
 // GetId returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.Id, and is useful for accessing the field via an interface.
@@ -5477,6 +5987,26 @@ func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClu
 	return v.ClusterQueueValues.HostedAgents
 }
 
+// GetDispatchPaused returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPaused, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPaused() bool {
+	return v.ClusterQueueDispatchValues.DispatchPaused
+}
+
+// GetDispatchPausedAt returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedAt, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedAt() *time.Time {
+	return v.ClusterQueueDispatchValues.DispatchPausedAt
+}
+
+// GetDispatchPausedBy returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedBy, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedBy() *ClusterQueueDispatchValuesDispatchPausedByUser {
+	return v.ClusterQueueDispatchValues.DispatchPausedBy
+}
+
+// GetDispatchPausedNote returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue.DispatchPausedNote, and is useful for accessing the field via an interface.
+func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) GetDispatchPausedNote() *string {
+	return v.ClusterQueueDispatchValues.DispatchPausedNote
+}
+
 func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -5499,18 +6029,15 @@ func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClu
 	if err != nil {
 		return err
 	}
+	err = json.Unmarshal(
+		b, &v.ClusterQueueDispatchValues)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
 type __premarshalgetClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue struct {
-	DispatchPaused bool `json:"dispatchPaused"`
-
-	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
-
-	DispatchPausedBy *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser `json:"dispatchPausedBy"`
-
-	DispatchPausedNote *string `json:"dispatchPausedNote"`
-
 	Id string `json:"id"`
 
 	Uuid string `json:"uuid"`
@@ -5524,6 +6051,14 @@ type __premarshalgetClusterQueuesOrganizationClusterQueuesClusterQueueConnection
 	Hosted bool `json:"hosted"`
 
 	HostedAgents ClusterQueueValuesHostedAgentsHostedAgentQueueSettings `json:"hostedAgents"`
+
+	DispatchPaused bool `json:"dispatchPaused"`
+
+	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
+
+	DispatchPausedBy *ClusterQueueDispatchValuesDispatchPausedByUser `json:"dispatchPausedBy"`
+
+	DispatchPausedNote *string `json:"dispatchPausedNote"`
 }
 
 func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) MarshalJSON() ([]byte, error) {
@@ -5537,10 +6072,6 @@ func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClu
 func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue) __premarshalJSON() (*__premarshalgetClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue, error) {
 	var retval __premarshalgetClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue
 
-	retval.DispatchPaused = v.DispatchPaused
-	retval.DispatchPausedAt = v.DispatchPausedAt
-	retval.DispatchPausedBy = v.DispatchPausedBy
-	retval.DispatchPausedNote = v.DispatchPausedNote
 	retval.Id = v.ClusterQueueValues.Id
 	retval.Uuid = v.ClusterQueueValues.Uuid
 	retval.Key = v.ClusterQueueValues.Key
@@ -5548,22 +6079,13 @@ func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClu
 	retval.Cluster = v.ClusterQueueValues.Cluster
 	retval.Hosted = v.ClusterQueueValues.Hosted
 	retval.HostedAgents = v.ClusterQueueValues.HostedAgents
+	retval.DispatchPaused = v.ClusterQueueDispatchValues.DispatchPaused
+	retval.DispatchPausedAt = v.ClusterQueueDispatchValues.DispatchPausedAt
+	retval.DispatchPausedBy = v.ClusterQueueDispatchValues.DispatchPausedBy
+	retval.DispatchPausedNote = v.ClusterQueueDispatchValues.DispatchPausedNote
 	return &retval, nil
 }
 
-// getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser includes the requested fields of the GraphQL type User.
-// The GraphQL type's documentation follows.
-//
-// A user
-type getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser struct {
-	Id string `json:"id"`
-}
-
-// GetId returns getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser.Id, and is useful for accessing the field via an interface.
-func (v *getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueueDispatchPausedByUser) GetId() string {
-	return v.Id
-}
-
 // getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
 // The GraphQL type's documentation follows.
 //
@@ -8054,97 +8576,394 @@ func (v *getOrganizationOrganization) GetMembersRequireTwoFactorAuthentication()
 	return v.MembersRequireTwoFactorAuthentication
 }
 
-// getOrganizationResponse is returned by getOrganization on success.
-type getOrganizationResponse struct {
-	// Find an organization
-	Organization getOrganizationOrganization `json:"organization"`
+// getOrganizationPipelinesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getOrganizationPipelinesOrganization struct {
+	// Return all the pipelines the current user has access to for this organization
+	Pipelines getOrganizationPipelinesOrganizationPipelinesPipelineConnection `json:"pipelines"`
 }
 
-// GetOrganization returns getOrganizationResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getOrganizationResponse) GetOrganization() getOrganizationOrganization {
-	return v.Organization
+// GetPipelines returns getOrganizationPipelinesOrganization.Pipelines, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganization) GetPipelines() getOrganizationPipelinesOrganizationPipelinesPipelineConnection {
+	return v.Pipelines
 }
 
-// getOrganizationRuleResponse is returned by getOrganizationRule on success.
-type getOrganizationRuleResponse struct {
-	// Find a rule via its UUID
-	Rule getOrganizationRuleRule `json:"rule"`
+// getOrganizationPipelinesOrganizationPipelinesPipelineConnection includes the requested fields of the GraphQL type PipelineConnection.
+type getOrganizationPipelinesOrganizationPipelinesPipelineConnection struct {
+	PageInfo getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo            `json:"pageInfo"`
+	Edges    []getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge `json:"edges"`
 }
 
-// GetRule returns getOrganizationRuleResponse.Rule, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleResponse) GetRule() getOrganizationRuleRule { return v.Rule }
-
-// getOrganizationRuleRule includes the requested fields of the GraphQL type Rule.
-type getOrganizationRuleRule struct {
-	OrganizationRuleFields `json:"-"`
+// GetPageInfo returns getOrganizationPipelinesOrganizationPipelinesPipelineConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnection) GetPageInfo() getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo {
+	return v.PageInfo
 }
 
-// GetId returns getOrganizationRuleRule.Id, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetId() string { return v.OrganizationRuleFields.Id }
+// GetEdges returns getOrganizationPipelinesOrganizationPipelinesPipelineConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnection) GetEdges() []getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge {
+	return v.Edges
+}
 
-// GetUuid returns getOrganizationRuleRule.Uuid, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetUuid() string { return v.OrganizationRuleFields.Uuid }
+// getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge includes the requested fields of the GraphQL type PipelineEdge.
+type getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge struct {
+	Node getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline `json:"node"`
+}
 
-// GetDescription returns getOrganizationRuleRule.Description, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetDescription() *string {
-	return v.OrganizationRuleFields.Description
+// GetNode returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge.Node, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdge) GetNode() getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline {
+	return v.Node
 }
 
-// GetDocument returns getOrganizationRuleRule.Document, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetDocument() string { return v.OrganizationRuleFields.Document }
+// getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline includes the requested fields of the GraphQL type Pipeline.
+// The GraphQL type's documentation follows.
+//
+// A pipeline
+type getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline struct {
+	PipelineFields `json:"-"`
+}
 
-// GetType returns getOrganizationRuleRule.Type, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetType() string { return v.OrganizationRuleFields.Type }
+// GetId returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetId() string {
+	return v.PipelineFields.Id
+}
 
-// GetSourceType returns getOrganizationRuleRule.SourceType, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetSourceType() RuleSourceType {
-	return v.OrganizationRuleFields.SourceType
+// GetPipelineUuid returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.PipelineUuid, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetPipelineUuid() string {
+	return v.PipelineFields.PipelineUuid
 }
 
-// GetTargetType returns getOrganizationRuleRule.TargetType, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetTargetType() RuleTargetType {
-	return v.OrganizationRuleFields.TargetType
+// GetAllowRebuilds returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.AllowRebuilds, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetAllowRebuilds() bool {
+	return v.PipelineFields.AllowRebuilds
 }
 
-// GetEffect returns getOrganizationRuleRule.Effect, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetEffect() RuleEffect { return v.OrganizationRuleFields.Effect }
+// GetBranchConfiguration returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.BranchConfiguration, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetBranchConfiguration() *string {
+	return v.PipelineFields.BranchConfiguration
+}
 
-// GetAction returns getOrganizationRuleRule.Action, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetAction() RuleAction { return v.OrganizationRuleFields.Action }
+// GetCancelIntermediateBuilds returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.CancelIntermediateBuilds, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetCancelIntermediateBuilds() bool {
+	return v.PipelineFields.CancelIntermediateBuilds
+}
 
-// GetSource returns getOrganizationRuleRule.Source, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetSource() OrganizationRuleFieldsSourceRuleSource {
-	return v.OrganizationRuleFields.Source
+// GetCancelIntermediateBuildsBranchFilter returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.CancelIntermediateBuildsBranchFilter, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetCancelIntermediateBuildsBranchFilter() string {
+	return v.PipelineFields.CancelIntermediateBuildsBranchFilter
 }
 
-// GetTarget returns getOrganizationRuleRule.Target, and is useful for accessing the field via an interface.
-func (v *getOrganizationRuleRule) GetTarget() OrganizationRuleFieldsTargetRuleTarget {
-	return v.OrganizationRuleFields.Target
+// GetCluster returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Cluster, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetCluster() PipelineFieldsCluster {
+	return v.PipelineFields.Cluster
 }
 
-func (v *getOrganizationRuleRule) UnmarshalJSON(b []byte) error {
+// GetColor returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Color, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetColor() *string {
+	return v.PipelineFields.Color
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetDefaultBranch returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.DefaultBranch, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetDefaultBranch() string {
+	return v.PipelineFields.DefaultBranch
+}
 
-	var firstPass struct {
-		*getOrganizationRuleRule
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getOrganizationRuleRule = v
+// GetDefaultTimeoutInMinutes returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.DefaultTimeoutInMinutes, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetDefaultTimeoutInMinutes() *int {
+	return v.PipelineFields.DefaultTimeoutInMinutes
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetEmoji returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Emoji, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetEmoji() *string {
+	return v.PipelineFields.Emoji
+}
 
-	err = json.Unmarshal(
-		b, &v.OrganizationRuleFields)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetMaximumTimeoutInMinutes returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.MaximumTimeoutInMinutes, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetMaximumTimeoutInMinutes() *int {
+	return v.PipelineFields.MaximumTimeoutInMinutes
+}
+
+// GetDescription returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Description, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetDescription() string {
+	return v.PipelineFields.Description
+}
+
+// GetName returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Name, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetName() string {
+	return v.PipelineFields.Name
+}
+
+// GetRepository returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Repository, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetRepository() PipelineFieldsRepository {
+	return v.PipelineFields.Repository
+}
+
+// GetPipelineTemplate returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.PipelineTemplate, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetPipelineTemplate() PipelineFieldsPipelineTemplate {
+	return v.PipelineFields.PipelineTemplate
+}
+
+// GetSkipIntermediateBuilds returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.SkipIntermediateBuilds, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetSkipIntermediateBuilds() bool {
+	return v.PipelineFields.SkipIntermediateBuilds
+}
+
+// GetSkipIntermediateBuildsBranchFilter returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.SkipIntermediateBuildsBranchFilter, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetSkipIntermediateBuildsBranchFilter() string {
+	return v.PipelineFields.SkipIntermediateBuildsBranchFilter
+}
+
+// GetSlug returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Slug, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetSlug() string {
+	return v.PipelineFields.Slug
+}
+
+// GetSteps returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Steps, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetSteps() PipelineFieldsStepsPipelineSteps {
+	return v.PipelineFields.Steps
+}
+
+// GetTags returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Tags, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetTags() []PipelineFieldsTagsPipelineTag {
+	return v.PipelineFields.Tags
+}
+
+// GetTeams returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline.Teams, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) GetTeams() PipelineFieldsTeamsTeamPipelineConnection {
+	return v.PipelineFields.Teams
+}
+
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PipelineFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline struct {
+	Id string `json:"id"`
+
+	PipelineUuid string `json:"pipelineUuid"`
+
+	AllowRebuilds bool `json:"allowRebuilds"`
+
+	BranchConfiguration *string `json:"branchConfiguration"`
+
+	CancelIntermediateBuilds bool `json:"cancelIntermediateBuilds"`
+
+	CancelIntermediateBuildsBranchFilter string `json:"cancelIntermediateBuildsBranchFilter"`
+
+	Cluster PipelineFieldsCluster `json:"cluster"`
+
+	Color *string `json:"color"`
+
+	DefaultBranch string `json:"defaultBranch"`
+
+	DefaultTimeoutInMinutes *int `json:"defaultTimeoutInMinutes"`
+
+	Emoji *string `json:"emoji"`
+
+	MaximumTimeoutInMinutes *int `json:"maximumTimeoutInMinutes"`
+
+	Description string `json:"description"`
+
+	Name string `json:"name"`
+
+	Repository PipelineFieldsRepository `json:"repository"`
+
+	PipelineTemplate PipelineFieldsPipelineTemplate `json:"pipelineTemplate"`
+
+	SkipIntermediateBuilds bool `json:"skipIntermediateBuilds"`
+
+	SkipIntermediateBuildsBranchFilter string `json:"skipIntermediateBuildsBranchFilter"`
+
+	Slug string `json:"slug"`
+
+	Steps PipelineFieldsStepsPipelineSteps `json:"steps"`
+
+	Tags []PipelineFieldsTagsPipelineTag `json:"tags"`
+
+	Teams PipelineFieldsTeamsTeamPipelineConnection `json:"teams"`
+}
+
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline) __premarshalJSON() (*__premarshalgetOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline, error) {
+	var retval __premarshalgetOrganizationPipelinesOrganizationPipelinesPipelineConnectionEdgesPipelineEdgeNodePipeline
+
+	retval.Id = v.PipelineFields.Id
+	retval.PipelineUuid = v.PipelineFields.PipelineUuid
+	retval.AllowRebuilds = v.PipelineFields.AllowRebuilds
+	retval.BranchConfiguration = v.PipelineFields.BranchConfiguration
+	retval.CancelIntermediateBuilds = v.PipelineFields.CancelIntermediateBuilds
+	retval.CancelIntermediateBuildsBranchFilter = v.PipelineFields.CancelIntermediateBuildsBranchFilter
+	retval.Cluster = v.PipelineFields.Cluster
+	retval.Color = v.PipelineFields.Color
+	retval.DefaultBranch = v.PipelineFields.DefaultBranch
+	retval.DefaultTimeoutInMinutes = v.PipelineFields.DefaultTimeoutInMinutes
+	retval.Emoji = v.PipelineFields.Emoji
+	retval.MaximumTimeoutInMinutes = v.PipelineFields.MaximumTimeoutInMinutes
+	retval.Description = v.PipelineFields.Description
+	retval.Name = v.PipelineFields.Name
+	retval.Repository = v.PipelineFields.Repository
+	retval.PipelineTemplate = v.PipelineFields.PipelineTemplate
+	retval.SkipIntermediateBuilds = v.PipelineFields.SkipIntermediateBuilds
+	retval.SkipIntermediateBuildsBranchFilter = v.PipelineFields.SkipIntermediateBuildsBranchFilter
+	retval.Slug = v.PipelineFields.Slug
+	retval.Steps = v.PipelineFields.Steps
+	retval.Tags = v.PipelineFields.Tags
+	retval.Teams = v.PipelineFields.Teams
+	return &retval, nil
+}
+
+// getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesOrganizationPipelinesPipelineConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// getOrganizationPipelinesResponse is returned by getOrganizationPipelines on success.
+type getOrganizationPipelinesResponse struct {
+	// Find an organization
+	Organization getOrganizationPipelinesOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationPipelinesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationPipelinesResponse) GetOrganization() getOrganizationPipelinesOrganization {
+	return v.Organization
+}
+
+// getOrganizationResponse is returned by getOrganization on success.
+type getOrganizationResponse struct {
+	// Find an organization
+	Organization getOrganizationOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationResponse) GetOrganization() getOrganizationOrganization {
+	return v.Organization
+}
+
+// getOrganizationRuleResponse is returned by getOrganizationRule on success.
+type getOrganizationRuleResponse struct {
+	// Find a rule via its UUID
+	Rule getOrganizationRuleRule `json:"rule"`
+}
+
+// GetRule returns getOrganizationRuleResponse.Rule, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleResponse) GetRule() getOrganizationRuleRule { return v.Rule }
+
+// getOrganizationRuleRule includes the requested fields of the GraphQL type Rule.
+type getOrganizationRuleRule struct {
+	OrganizationRuleFields `json:"-"`
+}
+
+// GetId returns getOrganizationRuleRule.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetId() string { return v.OrganizationRuleFields.Id }
+
+// GetUuid returns getOrganizationRuleRule.Uuid, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetUuid() string { return v.OrganizationRuleFields.Uuid }
+
+// GetDescription returns getOrganizationRuleRule.Description, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetDescription() *string {
+	return v.OrganizationRuleFields.Description
+}
+
+// GetDocument returns getOrganizationRuleRule.Document, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetDocument() string { return v.OrganizationRuleFields.Document }
+
+// GetType returns getOrganizationRuleRule.Type, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetType() string { return v.OrganizationRuleFields.Type }
+
+// GetSourceType returns getOrganizationRuleRule.SourceType, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetSourceType() RuleSourceType {
+	return v.OrganizationRuleFields.SourceType
+}
+
+// GetTargetType returns getOrganizationRuleRule.TargetType, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetTargetType() RuleTargetType {
+	return v.OrganizationRuleFields.TargetType
+}
+
+// GetEffect returns getOrganizationRuleRule.Effect, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetEffect() RuleEffect { return v.OrganizationRuleFields.Effect }
+
+// GetAction returns getOrganizationRuleRule.Action, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetAction() RuleAction { return v.OrganizationRuleFields.Action }
+
+// GetSource returns getOrganizationRuleRule.Source, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetSource() OrganizationRuleFieldsSourceRuleSource {
+	return v.OrganizationRuleFields.Source
+}
+
+// GetTarget returns getOrganizationRuleRule.Target, and is useful for accessing the field via an interface.
+func (v *getOrganizationRuleRule) GetTarget() OrganizationRuleFieldsTargetRuleTarget {
+	return v.OrganizationRuleFields.Target
+}
+
+func (v *getOrganizationRuleRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationRuleRule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationRuleRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationRuleFields)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
 type __premarshalgetOrganizationRuleRule struct {
@@ -8179,43 +8998,464 @@ func (v *getOrganizationRuleRule) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getOrganizationRuleRule) __premarshalJSON() (*__premarshalgetOrganizationRuleRule, error) {
-	var retval __premarshalgetOrganizationRuleRule
+func (v *getOrganizationRuleRule) __premarshalJSON() (*__premarshalgetOrganizationRuleRule, error) {
+	var retval __premarshalgetOrganizationRuleRule
+
+	retval.Id = v.OrganizationRuleFields.Id
+	retval.Uuid = v.OrganizationRuleFields.Uuid
+	retval.Description = v.OrganizationRuleFields.Description
+	retval.Document = v.OrganizationRuleFields.Document
+	retval.Type = v.OrganizationRuleFields.Type
+	retval.SourceType = v.OrganizationRuleFields.SourceType
+	retval.TargetType = v.OrganizationRuleFields.TargetType
+	retval.Effect = v.OrganizationRuleFields.Effect
+	retval.Action = v.OrganizationRuleFields.Action
+	{
+
+		dst := &retval.Source
+		src := v.OrganizationRuleFields.Source
+		var err error
+		*dst, err = __marshalOrganizationRuleFieldsSourceRuleSource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getOrganizationRuleRule.OrganizationRuleFields.Source: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Target
+		src := v.OrganizationRuleFields.Target
+		var err error
+		*dst, err = __marshalOrganizationRuleFieldsTargetRuleTarget(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getOrganizationRuleRule.OrganizationRuleFields.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getOrganizationRulesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getOrganizationRulesOrganization struct {
+	// Returns rules for an Organization
+	Rules getOrganizationRulesOrganizationRulesRuleConnection `json:"rules"`
+}
+
+// GetRules returns getOrganizationRulesOrganization.Rules, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganization) GetRules() getOrganizationRulesOrganizationRulesRuleConnection {
+	return v.Rules
+}
+
+// getOrganizationRulesOrganizationRulesRuleConnection includes the requested fields of the GraphQL type RuleConnection.
+type getOrganizationRulesOrganizationRulesRuleConnection struct {
+	PageInfo getOrganizationRulesOrganizationRulesRuleConnectionPageInfo        `json:"pageInfo"`
+	Edges    []getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge `json:"edges"`
+}
+
+// GetPageInfo returns getOrganizationRulesOrganizationRulesRuleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnection) GetPageInfo() getOrganizationRulesOrganizationRulesRuleConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns getOrganizationRulesOrganizationRulesRuleConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnection) GetEdges() []getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge {
+	return v.Edges
+}
+
+// getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge includes the requested fields of the GraphQL type RuleEdge.
+type getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge struct {
+	Node getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule `json:"node"`
+}
+
+// GetNode returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge.Node, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdge) GetNode() getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule {
+	return v.Node
+}
+
+// getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule includes the requested fields of the GraphQL type Rule.
+type getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule struct {
+	OrganizationRuleFields `json:"-"`
+}
+
+// GetId returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetId() string {
+	return v.OrganizationRuleFields.Id
+}
+
+// GetUuid returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Uuid, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetUuid() string {
+	return v.OrganizationRuleFields.Uuid
+}
+
+// GetDescription returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Description, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetDescription() *string {
+	return v.OrganizationRuleFields.Description
+}
+
+// GetDocument returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Document, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetDocument() string {
+	return v.OrganizationRuleFields.Document
+}
+
+// GetType returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Type, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetType() string {
+	return v.OrganizationRuleFields.Type
+}
+
+// GetSourceType returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.SourceType, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetSourceType() RuleSourceType {
+	return v.OrganizationRuleFields.SourceType
+}
+
+// GetTargetType returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.TargetType, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetTargetType() RuleTargetType {
+	return v.OrganizationRuleFields.TargetType
+}
+
+// GetEffect returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Effect, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetEffect() RuleEffect {
+	return v.OrganizationRuleFields.Effect
+}
+
+// GetAction returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Action, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetAction() RuleAction {
+	return v.OrganizationRuleFields.Action
+}
+
+// GetSource returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Source, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetSource() OrganizationRuleFieldsSourceRuleSource {
+	return v.OrganizationRuleFields.Source
+}
+
+// GetTarget returns getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.Target, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) GetTarget() OrganizationRuleFieldsTargetRuleTarget {
+	return v.OrganizationRuleFields.Target
+}
+
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationRuleFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule struct {
+	Id string `json:"id"`
+
+	Uuid string `json:"uuid"`
+
+	Description *string `json:"description"`
+
+	Document string `json:"document"`
+
+	Type string `json:"type"`
+
+	SourceType RuleSourceType `json:"sourceType"`
+
+	TargetType RuleTargetType `json:"targetType"`
+
+	Effect RuleEffect `json:"effect"`
+
+	Action RuleAction `json:"action"`
+
+	Source json.RawMessage `json:"source"`
+
+	Target json.RawMessage `json:"target"`
+}
+
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule) __premarshalJSON() (*__premarshalgetOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule, error) {
+	var retval __premarshalgetOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule
+
+	retval.Id = v.OrganizationRuleFields.Id
+	retval.Uuid = v.OrganizationRuleFields.Uuid
+	retval.Description = v.OrganizationRuleFields.Description
+	retval.Document = v.OrganizationRuleFields.Document
+	retval.Type = v.OrganizationRuleFields.Type
+	retval.SourceType = v.OrganizationRuleFields.SourceType
+	retval.TargetType = v.OrganizationRuleFields.TargetType
+	retval.Effect = v.OrganizationRuleFields.Effect
+	retval.Action = v.OrganizationRuleFields.Action
+	{
+
+		dst := &retval.Source
+		src := v.OrganizationRuleFields.Source
+		var err error
+		*dst, err = __marshalOrganizationRuleFieldsSourceRuleSource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.OrganizationRuleFields.Source: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Target
+		src := v.OrganizationRuleFields.Target
+		var err error
+		*dst, err = __marshalOrganizationRuleFieldsTargetRuleTarget(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getOrganizationRulesOrganizationRulesRuleConnectionEdgesRuleEdgeNodeRule.OrganizationRuleFields.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getOrganizationRulesOrganizationRulesRuleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getOrganizationRulesOrganizationRulesRuleConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getOrganizationRulesOrganizationRulesRuleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns getOrganizationRulesOrganizationRulesRuleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesOrganizationRulesRuleConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// getOrganizationRulesResponse is returned by getOrganizationRules on success.
+type getOrganizationRulesResponse struct {
+	// Find an organization
+	Organization getOrganizationRulesOrganization `json:"organization"`
+}
+
+// GetOrganization returns getOrganizationRulesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationRulesResponse) GetOrganization() getOrganizationRulesOrganization {
+	return v.Organization
+}
+
+// getOrganizationTeamsOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An organization
+type getOrganizationTeamsOrganization struct {
+	// Returns teams within the organization that the viewer can see
+	Teams getOrganizationTeamsOrganizationTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns getOrganizationTeamsOrganization.Teams, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganization) GetTeams() getOrganizationTeamsOrganizationTeamsTeamConnection {
+	return v.Teams
+}
+
+// getOrganizationTeamsOrganizationTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type getOrganizationTeamsOrganizationTeamsTeamConnection struct {
+	PageInfo getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo        `json:"pageInfo"`
+	Edges    []getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge `json:"edges"`
+}
+
+// GetPageInfo returns getOrganizationTeamsOrganizationTeamsTeamConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnection) GetPageInfo() getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns getOrganizationTeamsOrganizationTeamsTeamConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnection) GetEdges() []getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge {
+	return v.Edges
+}
+
+// getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge includes the requested fields of the GraphQL type TeamEdge.
+type getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge struct {
+	Node getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam `json:"node"`
+}
+
+// GetNode returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge.Node, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdge) GetNode() getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam {
+	return v.Node
+}
+
+// getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organization team
+type getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam struct {
+	TeamFields `json:"-"`
+}
+
+// GetId returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetId() string {
+	return v.TeamFields.Id
+}
+
+// GetUuid returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Uuid, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetUuid() string {
+	return v.TeamFields.Uuid
+}
+
+// GetName returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Name, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetName() string {
+	return v.TeamFields.Name
+}
+
+// GetDescription returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Description, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetDescription() string {
+	return v.TeamFields.Description
+}
+
+// GetSlug returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Slug, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetSlug() string {
+	return v.TeamFields.Slug
+}
+
+// GetPrivacy returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.Privacy, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetPrivacy() string {
+	return v.TeamFields.Privacy
+}
+
+// GetIsDefaultTeam returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.IsDefaultTeam, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetIsDefaultTeam() bool {
+	return v.TeamFields.IsDefaultTeam
+}
+
+// GetDefaultMemberRole returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.DefaultMemberRole, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetDefaultMemberRole() string {
+	return v.TeamFields.DefaultMemberRole
+}
+
+// GetMembersCanCreatePipelines returns getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam.MembersCanCreatePipelines, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) GetMembersCanCreatePipelines() bool {
+	return v.TeamFields.MembersCanCreatePipelines
+}
+
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TeamFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam struct {
+	Id string `json:"id"`
+
+	Uuid string `json:"uuid"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Slug string `json:"slug"`
+
+	Privacy string `json:"privacy"`
+
+	IsDefaultTeam bool `json:"isDefaultTeam"`
+
+	DefaultMemberRole string `json:"defaultMemberRole"`
+
+	MembersCanCreatePipelines bool `json:"membersCanCreatePipelines"`
+}
+
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam) __premarshalJSON() (*__premarshalgetOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam, error) {
+	var retval __premarshalgetOrganizationTeamsOrganizationTeamsTeamConnectionEdgesTeamEdgeNodeTeam
+
+	retval.Id = v.TeamFields.Id
+	retval.Uuid = v.TeamFields.Uuid
+	retval.Name = v.TeamFields.Name
+	retval.Description = v.TeamFields.Description
+	retval.Slug = v.TeamFields.Slug
+	retval.Privacy = v.TeamFields.Privacy
+	retval.IsDefaultTeam = v.TeamFields.IsDefaultTeam
+	retval.DefaultMemberRole = v.TeamFields.DefaultMemberRole
+	retval.MembersCanCreatePipelines = v.TeamFields.MembersCanCreatePipelines
+	return &retval, nil
+}
+
+// getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
 
-	retval.Id = v.OrganizationRuleFields.Id
-	retval.Uuid = v.OrganizationRuleFields.Uuid
-	retval.Description = v.OrganizationRuleFields.Description
-	retval.Document = v.OrganizationRuleFields.Document
-	retval.Type = v.OrganizationRuleFields.Type
-	retval.SourceType = v.OrganizationRuleFields.SourceType
-	retval.TargetType = v.OrganizationRuleFields.TargetType
-	retval.Effect = v.OrganizationRuleFields.Effect
-	retval.Action = v.OrganizationRuleFields.Action
-	{
+// GetHasNextPage returns getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsOrganizationTeamsTeamConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
 
-		dst := &retval.Source
-		src := v.OrganizationRuleFields.Source
-		var err error
-		*dst, err = __marshalOrganizationRuleFieldsSourceRuleSource(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getOrganizationRuleRule.OrganizationRuleFields.Source: %w", err)
-		}
-	}
-	{
+// getOrganizationTeamsResponse is returned by getOrganizationTeams on success.
+type getOrganizationTeamsResponse struct {
+	// Find an organization
+	Organization getOrganizationTeamsOrganization `json:"organization"`
+}
 
-		dst := &retval.Target
-		src := v.OrganizationRuleFields.Target
-		var err error
-		*dst, err = __marshalOrganizationRuleFieldsTargetRuleTarget(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getOrganizationRuleRule.OrganizationRuleFields.Target: %w", err)
-		}
-	}
-	return &retval, nil
+// GetOrganization returns getOrganizationTeamsResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getOrganizationTeamsResponse) GetOrganization() getOrganizationTeamsOrganization {
+	return v.Organization
 }
 
 // getOrganiztionBannerOrganization includes the requested fields of the GraphQL type Organization.
@@ -10418,6 +11658,178 @@ func (v *getPipelineScheduleResponse) __premarshalJSON() (*__premarshalgetPipeli
 	return &retval, nil
 }
 
+// getPipelineSchedulesPipeline includes the requested fields of the GraphQL type Pipeline.
+// The GraphQL type's documentation follows.
+//
+// A pipeline
+type getPipelineSchedulesPipeline struct {
+	// Schedules for this pipeline
+	Schedules getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection `json:"schedules"`
+}
+
+// GetSchedules returns getPipelineSchedulesPipeline.Schedules, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipeline) GetSchedules() getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection {
+	return v.Schedules
+}
+
+// getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection includes the requested fields of the GraphQL type PipelineScheduleConnection.
+type getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection struct {
+	Edges []getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge `json:"edges"`
+}
+
+// GetEdges returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnection) GetEdges() []getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge {
+	return v.Edges
+}
+
+// getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge includes the requested fields of the GraphQL type PipelineScheduleEdge.
+type getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge struct {
+	Node getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule `json:"node"`
+}
+
+// GetNode returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge.Node, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdge) GetNode() getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule {
+	return v.Node
+}
+
+// getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule includes the requested fields of the GraphQL type PipelineSchedule.
+// The GraphQL type's documentation follows.
+//
+// A schedule of when a build should automatically triggered for a Pipeline
+type getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule struct {
+	PipelineScheduleValues `json:"-"`
+}
+
+// GetId returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Id, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetId() string {
+	return v.PipelineScheduleValues.Id
+}
+
+// GetUuid returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Uuid, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetUuid() string {
+	return v.PipelineScheduleValues.Uuid
+}
+
+// GetLabel returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Label, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetLabel() *string {
+	return v.PipelineScheduleValues.Label
+}
+
+// GetCronline returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Cronline, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetCronline() *string {
+	return v.PipelineScheduleValues.Cronline
+}
+
+// GetMessage returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Message, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetMessage() *string {
+	return v.PipelineScheduleValues.Message
+}
+
+// GetCommit returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Commit, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetCommit() *string {
+	return v.PipelineScheduleValues.Commit
+}
+
+// GetBranch returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Branch, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetBranch() *string {
+	return v.PipelineScheduleValues.Branch
+}
+
+// GetEnv returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Env, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetEnv() []*string {
+	return v.PipelineScheduleValues.Env
+}
+
+// GetEnabled returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Enabled, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetEnabled() bool {
+	return v.PipelineScheduleValues.Enabled
+}
+
+// GetPipeline returns getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule.Pipeline, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) GetPipeline() PipelineScheduleValuesPipeline {
+	return v.PipelineScheduleValues.Pipeline
+}
+
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PipelineScheduleValues)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule struct {
+	Id string `json:"id"`
+
+	Uuid string `json:"uuid"`
+
+	Label *string `json:"label"`
+
+	Cronline *string `json:"cronline"`
+
+	Message *string `json:"message"`
+
+	Commit *string `json:"commit"`
+
+	Branch *string `json:"branch"`
+
+	Env []*string `json:"env"`
+
+	Enabled bool `json:"enabled"`
+
+	Pipeline PipelineScheduleValuesPipeline `json:"pipeline"`
+}
+
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule) __premarshalJSON() (*__premarshalgetPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule, error) {
+	var retval __premarshalgetPipelineSchedulesPipelineSchedulesPipelineScheduleConnectionEdgesPipelineScheduleEdgeNodePipelineSchedule
+
+	retval.Id = v.PipelineScheduleValues.Id
+	retval.Uuid = v.PipelineScheduleValues.Uuid
+	retval.Label = v.PipelineScheduleValues.Label
+	retval.Cronline = v.PipelineScheduleValues.Cronline
+	retval.Message = v.PipelineScheduleValues.Message
+	retval.Commit = v.PipelineScheduleValues.Commit
+	retval.Branch = v.PipelineScheduleValues.Branch
+	retval.Env = v.PipelineScheduleValues.Env
+	retval.Enabled = v.PipelineScheduleValues.Enabled
+	retval.Pipeline = v.PipelineScheduleValues.Pipeline
+	return &retval, nil
+}
+
+// getPipelineSchedulesResponse is returned by getPipelineSchedules on success.
+type getPipelineSchedulesResponse struct {
+	// Find a pipeline
+	Pipeline getPipelineSchedulesPipeline `json:"pipeline"`
+}
+
+// GetPipeline returns getPipelineSchedulesResponse.Pipeline, and is useful for accessing the field via an interface.
+func (v *getPipelineSchedulesResponse) GetPipeline() getPipelineSchedulesPipeline { return v.Pipeline }
+
 // getPipelineTeamsPipeline includes the requested fields of the GraphQL type Pipeline.
 // The GraphQL type's documentation follows.
 //
@@ -10642,47 +12054,164 @@ func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnec
 func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionEdgesPipelineTemplateEdgeNodePipelineTemplate) __premarshalJSON() (*__premarshalgetPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionEdgesPipelineTemplateEdgeNodePipelineTemplate, error) {
 	var retval __premarshalgetPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionEdgesPipelineTemplateEdgeNodePipelineTemplate
 
-	retval.Id = v.PipelineTemplateFields.Id
-	retval.Uuid = v.PipelineTemplateFields.Uuid
-	retval.Available = v.PipelineTemplateFields.Available
-	retval.Configuration = v.PipelineTemplateFields.Configuration
-	retval.Description = v.PipelineTemplateFields.Description
-	retval.Name = v.PipelineTemplateFields.Name
-	return &retval, nil
+	retval.Id = v.PipelineTemplateFields.Id
+	retval.Uuid = v.PipelineTemplateFields.Uuid
+	retval.Available = v.PipelineTemplateFields.Available
+	retval.Configuration = v.PipelineTemplateFields.Configuration
+	retval.Description = v.PipelineTemplateFields.Description
+	retval.Name = v.PipelineTemplateFields.Name
+	return &retval, nil
+}
+
+// getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// getPipelineTemplatesResponse is returned by getPipelineTemplates on success.
+type getPipelineTemplatesResponse struct {
+	// Find an organization
+	Organization getPipelineTemplatesOrganization `json:"organization"`
+}
+
+// GetOrganization returns getPipelineTemplatesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *getPipelineTemplatesResponse) GetOrganization() getPipelineTemplatesOrganization {
+	return v.Organization
+}
+
+// getTeamMembersResponse is returned by getTeamMembers on success.
+type getTeamMembersResponse struct {
+	// Find a team
+	Team getTeamMembersTeam `json:"team"`
+}
+
+// GetTeam returns getTeamMembersResponse.Team, and is useful for accessing the field via an interface.
+func (v *getTeamMembersResponse) GetTeam() getTeamMembersTeam { return v.Team }
+
+// getTeamMembersTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organization team
+type getTeamMembersTeam struct {
+	// Users that are part of this team
+	Members getTeamMembersTeamMembersTeamMemberConnection `json:"members"`
+}
+
+// GetMembers returns getTeamMembersTeam.Members, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeam) GetMembers() getTeamMembersTeamMembersTeamMemberConnection {
+	return v.Members
+}
+
+// getTeamMembersTeamMembersTeamMemberConnection includes the requested fields of the GraphQL type TeamMemberConnection.
+type getTeamMembersTeamMembersTeamMemberConnection struct {
+	PageInfo getTeamMembersTeamMembersTeamMemberConnectionPageInfo              `json:"pageInfo"`
+	Edges    []getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge `json:"edges"`
+}
+
+// GetPageInfo returns getTeamMembersTeamMembersTeamMemberConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnection) GetPageInfo() getTeamMembersTeamMembersTeamMemberConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns getTeamMembersTeamMembersTeamMemberConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnection) GetEdges() []getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge {
+	return v.Edges
+}
+
+// getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge includes the requested fields of the GraphQL type TeamMemberEdge.
+type getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge struct {
+	Node getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember `json:"node"`
+}
+
+// GetNode returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge.Node, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdge) GetNode() getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember {
+	return v.Node
+}
+
+// getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember includes the requested fields of the GraphQL type TeamMember.
+// The GraphQL type's documentation follows.
+//
+// An member of a team
+type getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember struct {
+	Id string `json:"id"`
+	// The user associated with this team member
+	User getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser `json:"user"`
+	// The users role within the team
+	Role string `json:"role"`
+}
+
+// GetId returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember) GetId() string {
+	return v.Id
+}
+
+// GetUser returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember.User, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember) GetUser() getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser {
+	return v.User
+}
+
+// GetRole returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember.Role, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMember) GetRole() string {
+	return v.Role
+}
+
+// getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user
+type getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser struct {
+	Id string `json:"id"`
+	// The primary email for the user
+	Email string `json:"email"`
+}
+
+// GetId returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser.Id, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser) GetId() string {
+	return v.Id
+}
+
+// GetEmail returns getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser.Email, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionEdgesTeamMemberEdgeNodeTeamMemberUser) GetEmail() string {
+	return v.Email
 }
 
-// getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// getTeamMembersTeamMembersTeamMemberConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
 // The GraphQL type's documentation follows.
 //
 // Information about pagination in a connection.
-type getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo struct {
+type getTeamMembersTeamMembersTeamMemberConnectionPageInfo struct {
 	// When paginating forwards, the cursor to continue.
 	EndCursor string `json:"endCursor"`
 	// When paginating forwards, are there more items?
 	HasNextPage bool `json:"hasNextPage"`
 }
 
-// GetEndCursor returns getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
-func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo) GetEndCursor() string {
+// GetEndCursor returns getTeamMembersTeamMembersTeamMemberConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionPageInfo) GetEndCursor() string {
 	return v.EndCursor
 }
 
-// GetHasNextPage returns getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
-func (v *getPipelineTemplatesOrganizationPipelineTemplatesPipelineTemplateConnectionPageInfo) GetHasNextPage() bool {
+// GetHasNextPage returns getTeamMembersTeamMembersTeamMemberConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getTeamMembersTeamMembersTeamMemberConnectionPageInfo) GetHasNextPage() bool {
 	return v.HasNextPage
 }
 
-// getPipelineTemplatesResponse is returned by getPipelineTemplates on success.
-type getPipelineTemplatesResponse struct {
-	// Find an organization
-	Organization getPipelineTemplatesOrganization `json:"organization"`
-}
-
-// GetOrganization returns getPipelineTemplatesResponse.Organization, and is useful for accessing the field via an interface.
-func (v *getPipelineTemplatesResponse) GetOrganization() getPipelineTemplatesOrganization {
-	return v.Organization
-}
-
 // getTestSuiteResponse is returned by getTestSuite on success.
 type getTestSuiteResponse struct {
 	// Fetches an object given its ID.
@@ -12336,18 +13865,142 @@ type getTestSuiteSuiteViewer struct {
 // GetTypename returns getTestSuiteSuiteViewer.Typename, and is useful for accessing the field via an interface.
 func (v *getTestSuiteSuiteViewer) GetTypename() string { return v.Typename }
 
+// getViewerResponse is returned by getViewer on success.
+type getViewerResponse struct {
+	// Context of the current user using the GraphQL API
+	Viewer getViewerViewer `json:"viewer"`
+}
+
+// GetViewer returns getViewerResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerResponse) GetViewer() getViewerViewer { return v.Viewer }
+
+// getViewerViewer includes the requested fields of the GraphQL type Viewer.
+// The GraphQL type's documentation follows.
+//
+// Represents the current user session
+type getViewerViewer struct {
+	// The current user
+	User getViewerViewerUser `json:"user"`
+}
+
+// GetUser returns getViewerViewer.User, and is useful for accessing the field via an interface.
+func (v *getViewerViewer) GetUser() getViewerViewerUser { return v.User }
+
+// getViewerViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user
+type getViewerViewerUser struct {
+	Id string `json:"id"`
+	// The public UUID of the user
+	Uuid string `json:"uuid"`
+	// The name of the user
+	Name string `json:"name"`
+	// The primary email for the user
+	Email string `json:"email"`
+}
+
+// GetId returns getViewerViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetId() string { return v.Id }
+
+// GetUuid returns getViewerViewerUser.Uuid, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetUuid() string { return v.Uuid }
+
+// GetName returns getViewerViewerUser.Name, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetName() string { return v.Name }
+
+// GetEmail returns getViewerViewerUser.Email, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetEmail() string { return v.Email }
+
 // pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload includes the requested fields of the GraphQL type ClusterQueuePauseDispatchPayload.
 // The GraphQL type's documentation follows.
 //
 // Autogenerated return type of ClusterQueuePauseDispatch.
 type pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload struct {
-	// A unique identifier for the client performing the mutation.
-	ClientMutationId string `json:"clientMutationId"`
+	Queue pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue `json:"queue"`
 }
 
-// GetClientMutationId returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload.ClientMutationId, and is useful for accessing the field via an interface.
-func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload) GetClientMutationId() string {
-	return v.ClientMutationId
+// GetQueue returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload.Queue, and is useful for accessing the field via an interface.
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayload) GetQueue() pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue {
+	return v.Queue
+}
+
+// pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue includes the requested fields of the GraphQL type ClusterQueue.
+type pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue struct {
+	ClusterQueueDispatchValues `json:"-"`
+}
+
+// GetDispatchPaused returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue.DispatchPaused, and is useful for accessing the field via an interface.
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) GetDispatchPaused() bool {
+	return v.ClusterQueueDispatchValues.DispatchPaused
+}
+
+// GetDispatchPausedAt returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue.DispatchPausedAt, and is useful for accessing the field via an interface.
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) GetDispatchPausedAt() *time.Time {
+	return v.ClusterQueueDispatchValues.DispatchPausedAt
+}
+
+// GetDispatchPausedBy returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue.DispatchPausedBy, and is useful for accessing the field via an interface.
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) GetDispatchPausedBy() *ClusterQueueDispatchValuesDispatchPausedByUser {
+	return v.ClusterQueueDispatchValues.DispatchPausedBy
+}
+
+// GetDispatchPausedNote returns pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue.DispatchPausedNote, and is useful for accessing the field via an interface.
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) GetDispatchPausedNote() *string {
+	return v.ClusterQueueDispatchValues.DispatchPausedNote
+}
+
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ClusterQueueDispatchValues)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalpauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue struct {
+	DispatchPaused bool `json:"dispatchPaused"`
+
+	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
+
+	DispatchPausedBy *ClusterQueueDispatchValuesDispatchPausedByUser `json:"dispatchPausedBy"`
+
+	DispatchPausedNote *string `json:"dispatchPausedNote"`
+}
+
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *pauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue) __premarshalJSON() (*__premarshalpauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue, error) {
+	var retval __premarshalpauseDispatchClusterQueueClusterQueuePauseDispatchClusterQueuePauseDispatchPayloadQueueClusterQueue
+
+	retval.DispatchPaused = v.ClusterQueueDispatchValues.DispatchPaused
+	retval.DispatchPausedAt = v.ClusterQueueDispatchValues.DispatchPausedAt
+	retval.DispatchPausedBy = v.ClusterQueueDispatchValues.DispatchPausedBy
+	retval.DispatchPausedNote = v.ClusterQueueDispatchValues.DispatchPausedNote
+	return &retval, nil
 }
 
 // pauseDispatchClusterQueueResponse is returned by pauseDispatchClusterQueue on success.
@@ -12859,6 +14512,39 @@ func (v *setOrganization2FAResponse) GetOrganizationEnforceTwoFactorAuthenticati
 	return v.OrganizationEnforceTwoFactorAuthenticationForMembersUpdate
 }
 
+// stopAgentAgentStopAgentStopPayload includes the requested fields of the GraphQL type AgentStopPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of AgentStop.
+type stopAgentAgentStopAgentStopPayload struct {
+	Agent stopAgentAgentStopAgentStopPayloadAgent `json:"agent"`
+}
+
+// GetAgent returns stopAgentAgentStopAgentStopPayload.Agent, and is useful for accessing the field via an interface.
+func (v *stopAgentAgentStopAgentStopPayload) GetAgent() stopAgentAgentStopAgentStopPayloadAgent {
+	return v.Agent
+}
+
+// stopAgentAgentStopAgentStopPayloadAgent includes the requested fields of the GraphQL type Agent.
+// The GraphQL type's documentation follows.
+//
+// An agent
+type stopAgentAgentStopAgentStopPayloadAgent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns stopAgentAgentStopAgentStopPayloadAgent.Id, and is useful for accessing the field via an interface.
+func (v *stopAgentAgentStopAgentStopPayloadAgent) GetId() string { return v.Id }
+
+// stopAgentResponse is returned by stopAgent on success.
+type stopAgentResponse struct {
+	// Instruct an agent to stop accepting new build jobs and shut itself down.
+	AgentStop stopAgentAgentStopAgentStopPayload `json:"agentStop"`
+}
+
+// GetAgentStop returns stopAgentResponse.AgentStop, and is useful for accessing the field via an interface.
+func (v *stopAgentResponse) GetAgentStop() stopAgentAgentStopAgentStopPayload { return v.AgentStop }
+
 // teamCreateResponse is returned by teamCreate on success.
 type teamCreateResponse struct {
 	// Create a team.
@@ -13421,35 +15107,8 @@ func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayload) GetClust
 
 // updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue includes the requested fields of the GraphQL type ClusterQueue.
 type updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue struct {
-	ClusterQueueValues `json:"-"`
-	// States whether job dispatch is paused for this cluster queue
-	DispatchPaused bool `json:"dispatchPaused"`
-	// The time this queue was paused
-	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
-	// The user who paused this cluster queue
-	DispatchPausedBy *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser `json:"dispatchPausedBy"`
-	// Note describing why job dispatch was paused for this cluster queue
-	DispatchPausedNote *string `json:"dispatchPausedNote"`
-}
-
-// GetDispatchPaused returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPaused, and is useful for accessing the field via an interface.
-func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPaused() bool {
-	return v.DispatchPaused
-}
-
-// GetDispatchPausedAt returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedAt, and is useful for accessing the field via an interface.
-func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedAt() *time.Time {
-	return v.DispatchPausedAt
-}
-
-// GetDispatchPausedBy returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedBy, and is useful for accessing the field via an interface.
-func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedBy() *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser {
-	return v.DispatchPausedBy
-}
-
-// GetDispatchPausedNote returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedNote, and is useful for accessing the field via an interface.
-func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedNote() *string {
-	return v.DispatchPausedNote
+	ClusterQueueValues         `json:"-"`
+	ClusterQueueDispatchValues `json:"-"`
 }
 
 // GetId returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.Id, and is useful for accessing the field via an interface.
@@ -13487,6 +15146,26 @@ func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQue
 	return v.ClusterQueueValues.HostedAgents
 }
 
+// GetDispatchPaused returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPaused, and is useful for accessing the field via an interface.
+func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPaused() bool {
+	return v.ClusterQueueDispatchValues.DispatchPaused
+}
+
+// GetDispatchPausedAt returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedAt, and is useful for accessing the field via an interface.
+func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedAt() *time.Time {
+	return v.ClusterQueueDispatchValues.DispatchPausedAt
+}
+
+// GetDispatchPausedBy returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedBy, and is useful for accessing the field via an interface.
+func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedBy() *ClusterQueueDispatchValuesDispatchPausedByUser {
+	return v.ClusterQueueDispatchValues.DispatchPausedBy
+}
+
+// GetDispatchPausedNote returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue.DispatchPausedNote, and is useful for accessing the field via an interface.
+func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) GetDispatchPausedNote() *string {
+	return v.ClusterQueueDispatchValues.DispatchPausedNote
+}
+
 func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -13509,18 +15188,15 @@ func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQue
 	if err != nil {
 		return err
 	}
+	err = json.Unmarshal(
+		b, &v.ClusterQueueDispatchValues)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
 type __premarshalupdateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue struct {
-	DispatchPaused bool `json:"dispatchPaused"`
-
-	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
-
-	DispatchPausedBy *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser `json:"dispatchPausedBy"`
-
-	DispatchPausedNote *string `json:"dispatchPausedNote"`
-
 	Id string `json:"id"`
 
 	Uuid string `json:"uuid"`
@@ -13534,6 +15210,14 @@ type __premarshalupdateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadCl
 	Hosted bool `json:"hosted"`
 
 	HostedAgents ClusterQueueValuesHostedAgentsHostedAgentQueueSettings `json:"hostedAgents"`
+
+	DispatchPaused bool `json:"dispatchPaused"`
+
+	DispatchPausedAt *time.Time `json:"dispatchPausedAt"`
+
+	DispatchPausedBy *ClusterQueueDispatchValuesDispatchPausedByUser `json:"dispatchPausedBy"`
+
+	DispatchPausedNote *string `json:"dispatchPausedNote"`
 }
 
 func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) MarshalJSON() ([]byte, error) {
@@ -13547,10 +15231,6 @@ func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQue
 func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue) __premarshalJSON() (*__premarshalupdateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue, error) {
 	var retval __premarshalupdateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueue
 
-	retval.DispatchPaused = v.DispatchPaused
-	retval.DispatchPausedAt = v.DispatchPausedAt
-	retval.DispatchPausedBy = v.DispatchPausedBy
-	retval.DispatchPausedNote = v.DispatchPausedNote
 	retval.Id = v.ClusterQueueValues.Id
 	retval.Uuid = v.ClusterQueueValues.Uuid
 	retval.Key = v.ClusterQueueValues.Key
@@ -13558,22 +15238,13 @@ func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQue
 	retval.Cluster = v.ClusterQueueValues.Cluster
 	retval.Hosted = v.ClusterQueueValues.Hosted
 	retval.HostedAgents = v.ClusterQueueValues.HostedAgents
+	retval.DispatchPaused = v.ClusterQueueDispatchValues.DispatchPaused
+	retval.DispatchPausedAt = v.ClusterQueueDispatchValues.DispatchPausedAt
+	retval.DispatchPausedBy = v.ClusterQueueDispatchValues.DispatchPausedBy
+	retval.DispatchPausedNote = v.ClusterQueueDispatchValues.DispatchPausedNote
 	return &retval, nil
 }
 
-// updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser includes the requested fields of the GraphQL type User.
-// The GraphQL type's documentation follows.
-//
-// A user
-type updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser struct {
-	Id string `json:"id"`
-}
-
-// GetId returns updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser.Id, and is useful for accessing the field via an interface.
-func (v *updateClusterQueueClusterQueueUpdateClusterQueueUpdatePayloadClusterQueueDispatchPausedByUser) GetId() string {
-	return v.Id
-}
-
 // updateClusterQueueResponse is returned by updateClusterQueue on success.
 type updateClusterQueueResponse struct {
 	// Updates a cluster queue.
@@ -15153,12 +16824,14 @@ fragment OrganizationRuleFields on Rule {
 	source {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
 	target {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
@@ -15256,6 +16929,7 @@ fragment PipelineTeam on TeamPipelineConnection {
 			accessLevel
 			team {
 				id
+				slug
 			}
 		}
 	}
@@ -15951,27 +17625,122 @@ const getAgentToken_Operation = `
 query getAgentToken ($slug: ID!) {
 	agentToken(slug: $slug) {
 		id
-		description
+		description
+		uuid
+	}
+}
+`
+
+func getAgentToken(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	slug string,
+) (*getAgentTokenResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getAgentToken",
+		Query:  getAgentToken_Operation,
+		Variables: &__getAgentTokenInput{
+			Slug: slug,
+		},
+	}
+	var err_ error
+
+	var data_ getAgentTokenResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getAgentTokenValue.
+const getAgentTokenValue_Operation = `
+query getAgentTokenValue ($slug: ID!) {
+	agentToken(slug: $slug) {
+		id
+		description
+		uuid
+		token
+		revokedAt
+	}
+}
+`
+
+func getAgentTokenValue(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	slug string,
+) (*getAgentTokenValueResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getAgentTokenValue",
+		Query:  getAgentTokenValue_Operation,
+		Variables: &__getAgentTokenValueInput{
+			Slug: slug,
+		},
+	}
+	var err_ error
+
+	var data_ getAgentTokenValueResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getClusterAgentTokenValues.
+const getClusterAgentTokenValues_Operation = `
+query getClusterAgentTokenValues ($orgSlug: ID!, $id: ID!) {
+	organization(slug: $orgSlug) {
+		cluster(id: $id) {
+			agentTokens(first: 50) {
+				edges {
+					node {
+						... ClusterAgentTokenValues
+						token
+					}
+				}
+			}
+		}
+	}
+}
+fragment ClusterAgentTokenValues on ClusterToken {
+	allowedIpAddresses
+	cluster {
+		id
 		uuid
 	}
+	description
+	id
+	uuid
 }
 `
 
-func getAgentToken(
+func getClusterAgentTokenValues(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
-) (*getAgentTokenResponse, error) {
+	orgSlug string,
+	id string,
+) (*getClusterAgentTokenValuesResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getAgentToken",
-		Query:  getAgentToken_Operation,
-		Variables: &__getAgentTokenInput{
-			Slug: slug,
+		OpName: "getClusterAgentTokenValues",
+		Query:  getClusterAgentTokenValues_Operation,
+		Variables: &__getClusterAgentTokenValuesInput{
+			OrgSlug: orgSlug,
+			Id:      id,
 		},
 	}
 	var err_ error
 
-	var data_ getAgentTokenResponse
+	var data_ getClusterAgentTokenValuesResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -16099,6 +17868,135 @@ func getClusterByName(
 	return &data_, err_
 }
 
+// The query or mutation executed by getClusterQueueAgents.
+const getClusterQueueAgents_Operation = `
+query getClusterQueueAgents ($orgSlug: ID!, $queueId: ID!, $cursor: String) {
+	organization(slug: $orgSlug) {
+		agents(first: 100, after: $cursor, clusterQueue: [$queueId]) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					id
+					name
+					connectionState
+				}
+			}
+		}
+	}
+}
+`
+
+func getClusterQueueAgents(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	orgSlug string,
+	queueId string,
+	cursor *string,
+) (*getClusterQueueAgentsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getClusterQueueAgents",
+		Query:  getClusterQueueAgents_Operation,
+		Variables: &__getClusterQueueAgentsInput{
+			OrgSlug: orgSlug,
+			QueueId: queueId,
+			Cursor:  cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getClusterQueueAgentsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getClusterQueueRunningJobs.
+const getClusterQueueRunningJobs_Operation = `
+query getClusterQueueRunningJobs ($orgSlug: ID!, $queueId: ID!) {
+	organization(slug: $orgSlug) {
+		jobs(first: 1, clusterQueue: [$queueId], state: [ASSIGNED,ACCEPTED,RUNNING,CANCELING,TIMING_OUT]) {
+			count
+		}
+	}
+}
+`
+
+func getClusterQueueRunningJobs(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	orgSlug string,
+	queueId string,
+) (*getClusterQueueRunningJobsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getClusterQueueRunningJobs",
+		Query:  getClusterQueueRunningJobs_Operation,
+		Variables: &__getClusterQueueRunningJobsInput{
+			OrgSlug: orgSlug,
+			QueueId: queueId,
+		},
+	}
+	var err_ error
+
+	var data_ getClusterQueueRunningJobsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getClusterQueueScheduledJobs.
+const getClusterQueueScheduledJobs_Operation = `
+query getClusterQueueScheduledJobs ($orgSlug: ID!, $queueId: ID!) {
+	organization(slug: $orgSlug) {
+		jobs(first: 1, clusterQueue: [$queueId], state: [SCHEDULED]) {
+			count
+		}
+	}
+}
+`
+
+func getClusterQueueScheduledJobs(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	orgSlug string,
+	queueId string,
+) (*getClusterQueueScheduledJobsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getClusterQueueScheduledJobs",
+		Query:  getClusterQueueScheduledJobs_Operation,
+		Variables: &__getClusterQueueScheduledJobsInput{
+			OrgSlug: orgSlug,
+			QueueId: queueId,
+		},
+	}
+	var err_ error
+
+	var data_ getClusterQueueScheduledJobsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getClusterQueues.
 const getClusterQueues_Operation = `
 query getClusterQueues ($orgSlug: ID!, $id: ID!, $cursor: String) {
@@ -16112,12 +18010,7 @@ query getClusterQueues ($orgSlug: ID!, $id: ID!, $cursor: String) {
 				edges {
 					node {
 						... ClusterQueueValues
-						dispatchPaused
-						dispatchPausedAt
-						dispatchPausedBy {
-							id
-						}
-						dispatchPausedNote
+						... ClusterQueueDispatchValues
 					}
 				}
 			}
@@ -16138,6 +18031,14 @@ fragment ClusterQueueValues on ClusterQueue {
 		... HostedAgentsQueueSettingsValues
 	}
 }
+fragment ClusterQueueDispatchValues on ClusterQueue {
+	dispatchPaused
+	dispatchPausedAt
+	dispatchPausedBy {
+		id
+	}
+	dispatchPausedNote
+}
 fragment HostedAgentsQueueSettingsValues on HostedAgentQueueSettings {
 	instanceShape {
 		architecture
@@ -16276,12 +18177,14 @@ fragment OrganizationRuleFields on Rule {
 	source {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
 	target {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
@@ -16357,6 +18260,7 @@ fragment PipelineTeam on TeamPipelineConnection {
 			accessLevel
 			team {
 				id
+				slug
 			}
 		}
 	}
@@ -16401,21 +18305,182 @@ query getOrganization ($slug: ID!) {
 }
 `
 
-func getOrganization(
+func getOrganization(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	slug string,
+) (*getOrganizationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganization",
+		Query:  getOrganization_Operation,
+		Variables: &__getOrganizationInput{
+			Slug: slug,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationPipelines.
+const getOrganizationPipelines_Operation = `
+query getOrganizationPipelines ($orgSlug: ID!, $cursor: String) {
+	organization(slug: $orgSlug) {
+		pipelines(order: NAME, archived: false, first: 50, after: $cursor) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					... PipelineFields
+				}
+			}
+		}
+	}
+}
+fragment PipelineFields on Pipeline {
+	id
+	pipelineUuid: uuid
+	allowRebuilds
+	branchConfiguration
+	cancelIntermediateBuilds
+	cancelIntermediateBuildsBranchFilter
+	cluster {
+		id
+	}
+	color
+	defaultBranch
+	defaultTimeoutInMinutes
+	emoji
+	maximumTimeoutInMinutes
+	description
+	name
+	repository {
+		url
+	}
+	pipelineTemplate {
+		id
+	}
+	skipIntermediateBuilds
+	skipIntermediateBuildsBranchFilter
+	slug
+	steps {
+		yaml
+	}
+	tags {
+		label
+	}
+	teams(first: 5, order: NAME) {
+		... PipelineTeam
+	}
+}
+fragment PipelineTeam on TeamPipelineConnection {
+	pageInfo {
+		endCursor
+		hasNextPage
+	}
+	count
+	edges {
+		cursor
+		node {
+			id
+			accessLevel
+			team {
+				id
+				slug
+			}
+		}
+	}
+}
+`
+
+func getOrganizationPipelines(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	orgSlug string,
+	cursor *string,
+) (*getOrganizationPipelinesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationPipelines",
+		Query:  getOrganizationPipelines_Operation,
+		Variables: &__getOrganizationPipelinesInput{
+			OrgSlug: orgSlug,
+			Cursor:  cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationPipelinesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationRule.
+const getOrganizationRule_Operation = `
+query getOrganizationRule ($uuid: ID!) {
+	rule(uuid: $uuid) {
+		... OrganizationRuleFields
+	}
+}
+fragment OrganizationRuleFields on Rule {
+	id
+	uuid
+	description
+	document
+	type
+	sourceType
+	targetType
+	effect
+	action
+	source {
+		__typename
+		... on Pipeline {
+			id
+			uuid
+		}
+	}
+	target {
+		__typename
+		... on Pipeline {
+			id
+			uuid
+		}
+	}
+}
+`
+
+func getOrganizationRule(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
-) (*getOrganizationResponse, error) {
+	uuid string,
+) (*getOrganizationRuleResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getOrganization",
-		Query:  getOrganization_Operation,
-		Variables: &__getOrganizationInput{
-			Slug: slug,
+		OpName: "getOrganizationRule",
+		Query:  getOrganizationRule_Operation,
+		Variables: &__getOrganizationRuleInput{
+			Uuid: uuid,
 		},
 	}
 	var err_ error
 
-	var data_ getOrganizationResponse
+	var data_ getOrganizationRuleResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -16427,11 +18492,21 @@ func getOrganization(
 	return &data_, err_
 }
 
-// The query or mutation executed by getOrganizationRule.
-const getOrganizationRule_Operation = `
-query getOrganizationRule ($uuid: ID!) {
-	rule(uuid: $uuid) {
-		... OrganizationRuleFields
+// The query or mutation executed by getOrganizationRules.
+const getOrganizationRules_Operation = `
+query getOrganizationRules ($orgSlug: ID!, $cursor: String) {
+	organization(slug: $orgSlug) {
+		rules(first: 50, after: $cursor) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					... OrganizationRuleFields
+				}
+			}
+		}
 	}
 }
 fragment OrganizationRuleFields on Rule {
@@ -16447,33 +18522,95 @@ fragment OrganizationRuleFields on Rule {
 	source {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
 	target {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
 }
 `
 
-func getOrganizationRule(
+func getOrganizationRules(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	uuid string,
-) (*getOrganizationRuleResponse, error) {
+	orgSlug string,
+	cursor *string,
+) (*getOrganizationRulesResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getOrganizationRule",
-		Query:  getOrganizationRule_Operation,
-		Variables: &__getOrganizationRuleInput{
-			Uuid: uuid,
+		OpName: "getOrganizationRules",
+		Query:  getOrganizationRules_Operation,
+		Variables: &__getOrganizationRulesInput{
+			OrgSlug: orgSlug,
+			Cursor:  cursor,
 		},
 	}
 	var err_ error
 
-	var data_ getOrganizationRuleResponse
+	var data_ getOrganizationRulesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getOrganizationTeams.
+const getOrganizationTeams_Operation = `
+query getOrganizationTeams ($orgSlug: ID!, $cursor: String) {
+	organization(slug: $orgSlug) {
+		teams(order: NAME, first: 50, after: $cursor) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					... TeamFields
+				}
+			}
+		}
+	}
+}
+fragment TeamFields on Team {
+	id
+	uuid
+	name
+	description
+	slug
+	privacy
+	isDefaultTeam
+	defaultMemberRole
+	membersCanCreatePipelines
+}
+`
+
+func getOrganizationTeams(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	orgSlug string,
+	cursor *string,
+) (*getOrganizationTeamsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getOrganizationTeams",
+		Query:  getOrganizationTeams_Operation,
+		Variables: &__getOrganizationTeamsInput{
+			OrgSlug: orgSlug,
+			Cursor:  cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getOrganizationTeamsResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -16588,6 +18725,7 @@ fragment PipelineTeam on TeamPipelineConnection {
 			accessLevel
 			team {
 				id
+				slug
 			}
 		}
 	}
@@ -16721,6 +18859,61 @@ func getPipelineScheduleBySlug(
 	return &data_, err_
 }
 
+// The query or mutation executed by getPipelineSchedules.
+const getPipelineSchedules_Operation = `
+query getPipelineSchedules ($slug: ID!) {
+	pipeline(slug: $slug) {
+		schedules(first: 500) {
+			edges {
+				node {
+					... PipelineScheduleValues
+				}
+			}
+		}
+	}
+}
+fragment PipelineScheduleValues on PipelineSchedule {
+	id
+	uuid
+	label
+	cronline
+	message
+	commit
+	branch
+	env
+	enabled
+	pipeline {
+		id
+	}
+}
+`
+
+func getPipelineSchedules(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	slug string,
+) (*getPipelineSchedulesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getPipelineSchedules",
+		Query:  getPipelineSchedules_Operation,
+		Variables: &__getPipelineSchedulesInput{
+			Slug: slug,
+		},
+	}
+	var err_ error
+
+	var data_ getPipelineSchedulesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getPipelineTeams.
 const getPipelineTeams_Operation = `
 query getPipelineTeams ($slug: ID!, $cursor: String) {
@@ -16743,6 +18936,7 @@ fragment PipelineTeam on TeamPipelineConnection {
 			accessLevel
 			team {
 				id
+				slug
 			}
 		}
 	}
@@ -16832,6 +19026,58 @@ func getPipelineTemplates(
 	return &data_, err_
 }
 
+// The query or mutation executed by getTeamMembers.
+const getTeamMembers_Operation = `
+query getTeamMembers ($slug: ID!, $cursor: String) {
+	team(slug: $slug) {
+		members(first: 100, after: $cursor) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					id
+					user {
+						id
+						email
+					}
+					role
+				}
+			}
+		}
+	}
+}
+`
+
+func getTeamMembers(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	slug string,
+	cursor *string,
+) (*getTeamMembersResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getTeamMembers",
+		Query:  getTeamMembers_Operation,
+		Variables: &__getTeamMembersInput{
+			Slug:   slug,
+			Cursor: cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getTeamMembersResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getTestSuite.
 const getTestSuite_Operation = `
 query getTestSuite ($id: ID!, $teamCount: Int) {
@@ -16887,12 +19133,58 @@ func getTestSuite(
 	return &data_, err_
 }
 
+// The query or mutation executed by getViewer.
+const getViewer_Operation = `
+query getViewer {
+	viewer {
+		user {
+			id
+			uuid
+			name
+			email
+		}
+	}
+}
+`
+
+func getViewer(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*getViewerResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getViewer",
+		Query:  getViewer_Operation,
+	}
+	var err_ error
+
+	var data_ getViewerResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by pauseDispatchClusterQueue.
 const pauseDispatchClusterQueue_Operation = `
-mutation pauseDispatchClusterQueue ($id: ID!) {
-	clusterQueuePauseDispatch(input: {id:$id}) {
-		clientMutationId
+mutation pauseDispatchClusterQueue ($id: ID!, $note: String) {
+	clusterQueuePauseDispatch(input: {id:$id,note:$note}) {
+		queue {
+			... ClusterQueueDispatchValues
+		}
+	}
+}
+fragment ClusterQueueDispatchValues on ClusterQueue {
+	dispatchPaused
+	dispatchPausedAt
+	dispatchPausedBy {
+		id
 	}
+	dispatchPausedNote
 }
 `
 
@@ -16900,12 +19192,14 @@ func pauseDispatchClusterQueue(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	id string,
+	note *string,
 ) (*pauseDispatchClusterQueueResponse, error) {
 	req_ := &graphql.Request{
 		OpName: "pauseDispatchClusterQueue",
 		Query:  pauseDispatchClusterQueue_Operation,
 		Variables: &__pauseDispatchClusterQueueInput{
-			Id: id,
+			Id:   id,
+			Note: note,
 		},
 	}
 	var err_ error
@@ -17234,6 +19528,43 @@ func setOrganization2FA(
 	return &data_, err_
 }
 
+// The query or mutation executed by stopAgent.
+const stopAgent_Operation = `
+mutation stopAgent ($id: ID!) {
+	agentStop(input: {id:$id,graceful:true}) {
+		agent {
+			id
+		}
+	}
+}
+`
+
+func stopAgent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*stopAgentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "stopAgent",
+		Query:  stopAgent_Operation,
+		Variables: &__stopAgentInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ stopAgentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by teamCreate.
 const teamCreate_Operation = `
 mutation teamCreate ($organizationID: ID!, $name: String!, $description: String, $privacy: TeamPrivacy!, $isDefaultTeam: Boolean!, $defaultMemberRole: TeamMemberRole!, $membersCanCreatePipelines: Boolean) {
@@ -17512,12 +19843,7 @@ mutation updateClusterQueue ($organizationId: ID!, $id: ID!, $description: Strin
 	clusterQueueUpdate(input: {organizationId:$organizationId,id:$id,description:$description,hostedAgents:$hostedAgents}) {
 		clusterQueue {
 			... ClusterQueueValues
-			dispatchPaused
-			dispatchPausedAt
-			dispatchPausedBy {
-				id
-			}
-			dispatchPausedNote
+			... ClusterQueueDispatchValues
 		}
 	}
 }
@@ -17535,6 +19861,14 @@ fragment ClusterQueueValues on ClusterQueue {
 		... HostedAgentsQueueSettingsValues
 	}
 }
+fragment ClusterQueueDispatchValues on ClusterQueue {
+	dispatchPaused
+	dispatchPausedAt
+	dispatchPausedBy {
+		id
+	}
+	dispatchPausedNote
+}
 fragment HostedAgentsQueueSettingsValues on HostedAgentQueueSettings {
 	instanceShape {
 		architecture
@@ -17609,12 +19943,14 @@ fragment OrganizationRuleFields on Rule {
 	source {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
 	target {
 		__typename
 		... on Pipeline {
+			id
 			uuid
 		}
 	}
@@ -17711,6 +20047,7 @@ fragment PipelineTeam on TeamPipelineConnection {
 			accessLevel
 			team {
 				id
+				slug
 			}
 		}
 	}
@@ -0,0 +1,34 @@
+package buildkite
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// isGraphQLID reports whether id is the GraphQL ID of a node of the given type. Buildkite IDs are the base64 encoding
+// of the type name and UUID, so they can be told apart from the natural identifiers resources also accept on import.
+func isGraphQLID(id, typename string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(string(decoded), typename+"---")
+}
+
+// splitImportID splits a natural import identifier made up of two parts separated by a slash. The first part is a
+// slug, which never contains a slash, so the second part is free to.
+func splitImportID(id string) (string, string, bool) {
+	first, second, ok := strings.Cut(id, "/")
+	return first, second, ok && first != "" && second != ""
+}
+
+// addImportIDError reports an import identifier matching none of the formats a resource accepts
+func addImportIDError(diags *diag.Diagnostics, id string, formats ...string) {
+	diags.AddError(
+		"Unexpected Import Identifier",
+		fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(formats, " or "), id),
+	)
+}
@@ -0,0 +1,103 @@
+package buildkite
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestIsGraphQLID(t *testing.T) {
+	testCases := map[string]struct {
+		id       string
+		typename string
+		expected bool
+	}{
+		"pipeline ID": {
+			id:       "UGlwZWxpbmUtLS00MzVjYWQ1OC1lODFkLTQ1YWYtODYzNy1iMWNmODA3MDIzOGQ=",
+			typename: "Pipeline",
+			expected: true,
+		},
+		"ID of another type": {
+			id:       "UGlwZWxpbmUtLS00MzVjYWQ1OC1lODFkLTQ1YWYtODYzNy1iMWNmODA3MDIzOGQ=",
+			typename: "PipelineSchedule",
+			expected: false,
+		},
+		"pipeline slug": {
+			id:       "my-pipeline",
+			typename: "Pipeline",
+			expected: false,
+		},
+		"slug that is valid base64": {
+			id:       "deploy12",
+			typename: "Pipeline",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := isGraphQLID(testCase.id, testCase.typename); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	testCases := map[string]struct {
+		id     string
+		first  string
+		second string
+		ok     bool
+	}{
+		"two parts": {
+			id:     "everyone/a.smith@example.com",
+			first:  "everyone",
+			second: "a.smith@example.com",
+			ok:     true,
+		},
+		"second part with a slash": {
+			id:     "deploy/nightly/production",
+			first:  "deploy",
+			second: "nightly/production",
+			ok:     true,
+		},
+		"one part": {
+			id:    "deploy",
+			first: "deploy",
+		},
+		"empty part": {
+			id:    "deploy/",
+			first: "deploy",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			first, second, ok := splitImportID(testCase.id)
+			if first != testCase.first || second != testCase.second || ok != testCase.ok {
+				t.Errorf("expected %q, %q, %t, got %q, %q, %t", testCase.first, testCase.second, testCase.ok, first, second, ok)
+			}
+		})
+	}
+}
+
+// testAccImportStateID builds a natural import identifier from format and the values of attributes in state, each
+// given as the resource address followed by the attribute name
+func testAccImportStateID(format string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		values := make([]any, len(attributes))
+		for i, attribute := range attributes {
+			separator := strings.LastIndex(attribute, ".")
+			rs, ok := s.RootModule().Resources[attribute[:separator]]
+			if !ok {
+				return "", fmt.Errorf("not found in state: %s", attribute[:separator])
+			}
+			values[i] = rs.Primary.Attributes[attribute[separator+1:]]
+		}
+		return fmt.Sprintf(format, values...), nil
+	}
+}
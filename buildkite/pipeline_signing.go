@@ -0,0 +1,404 @@
+package buildkite
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/buildkite/go-pipeline"
+	"github.com/buildkite/go-pipeline/signature"
+	"github.com/buildkite/interpolate"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"gopkg.in/yaml.v3"
+)
+
+// Modes for handling environment interpolations in steps that are signed
+const (
+	// interpolationReject fails signing if the steps contain any environment interpolations
+	interpolationReject = "reject"
+	// interpolationResolveWithEnv substitutes every interpolation with a value from the env, failing if one has none
+	interpolationResolveWithEnv = "resolve_with_env"
+	// interpolationPreserveRuntime substitutes interpolations with a value in the env, and leaves the others in the
+	// steps for the shell to expand when the job runs
+	interpolationPreserveRuntime = "preserve_runtime"
+)
+
+var interpolationModes = []string{interpolationReject, interpolationResolveWithEnv, interpolationPreserveRuntime}
+
+// envReferencePattern matches escaped dollar signs and the start of references to environment variables
+var envReferencePattern = regexp.MustCompile(`\$\$|\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+
+// stepsInterpolation configures how environment interpolations in steps are handled when signing them. The zero
+// value rejects them.
+type stepsInterpolation struct {
+	Mode string
+	Env  map[string]string
+}
+
+// signPipelineSteps signs the steps of a pipeline in YAML format with a key from the JWKS, returning the signed
+// pipeline in YAML format. stepsInput names the input the steps came from for use in error messages. If keyID is null
+// the JWKS must contain exactly one key.
+func signPipelineSteps(
+	ctx context.Context,
+	unsignedSteps, stepsInput, repository string,
+	jwksContents []byte,
+	keyID types.String,
+	interpolation stepsInterpolation,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expansions, err := interpolate.Identifiers(unsignedSteps)
+	if err != nil {
+		diags.AddError("Failed to discover environment expansions in unsigned steps", err.Error())
+		return "", diags
+	}
+
+	if len(expansions) > 0 && (interpolation.Mode == "" || interpolation.Mode == interpolationReject) {
+		for i, e := range expansions {
+			// in interpolate, the identifiers of expansions don't have the $ prefix, and escaped expansions only have one
+			expansions[i] = "$" + e
+		}
+
+		err = fmt.Errorf("pipeline contains environment interpolations, which are only supported when dynamically "+
+			"uploading a pipeline, and not when statically signing pipelines using this tool. "+
+			"Note that terraform interpolations (eg `${var.some_variable}`) are fully supported, but environment interpolations "+
+			"(eg `$SOME_VARIABLE`) are not. "+
+			"Please remove the following interpolation directives from the `%s` input: %s", stepsInput, strings.Join(expansions, ", "))
+		diags.AddError("Environment interpolations are not allowed", err.Error())
+		return "", diags
+	}
+
+	p, err := pipeline.Parse(strings.NewReader(unsignedSteps))
+	if err != nil {
+		diags.AddError("Unable to parse pipeline steps", err.Error())
+		return "", diags
+	}
+
+	if len(expansions) > 0 {
+		p, diags = interpolatePipeline(unsignedSteps, p, expansions, interpolation)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	key, keyDiags := selectJWKSKey(jwksContents, keyID)
+	diags.Append(keyDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if err := signature.SignSteps(ctx, p.Steps, key, repository, signature.WithEnv(p.Env.ToMap())); err != nil {
+		diags.AddError("Failed to sign pipeline", err.Error())
+		return "", diags
+	}
+
+	signedSteps, err := yaml.Marshal(p)
+	if err != nil {
+		diags.AddError("Failed to marshal pipeline", err.Error())
+		return "", diags
+	}
+
+	return string(signedSteps), diags
+}
+
+// mapInterpolationEnv is the environment the pipeline is interpolated with
+type mapInterpolationEnv map[string]string
+
+func (e mapInterpolationEnv) Get(name string) (string, bool) {
+	value, ok := e[name]
+	return value, ok
+}
+
+func (e mapInterpolationEnv) Set(name, value string) {
+	e[name] = value
+}
+
+// interpolatePipeline interpolates the environment into the steps the same way the agent does when uploading a
+// pipeline. Variables are resolved from the interpolation's env and the pipeline's own env block; with
+// interpolationPreserveRuntime any others are escaped first so they are kept as they are.
+func interpolatePipeline(
+	unsignedSteps string,
+	p *pipeline.Pipeline,
+	expansions []string,
+	interpolation stepsInterpolation,
+) (*pipeline.Pipeline, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	known := func(name string) bool {
+		if _, ok := interpolation.Env[name]; ok {
+			return true
+		}
+		_, ok := p.Env.Get(name)
+		return ok
+	}
+
+	switch interpolation.Mode {
+	case interpolationResolveWithEnv:
+		var missing []string
+		for _, e := range expansions {
+			// escaped expansions are prefixed with a $ and become literal text rather than needing a value
+			if !strings.HasPrefix(e, "$") && !known(e) {
+				missing = append(missing, "$"+e)
+			}
+		}
+		if len(missing) > 0 {
+			diags.AddError(
+				"Environment interpolations without a value",
+				fmt.Sprintf("The following interpolations have no value in `env` or the pipeline's env block: %s. "+
+					"Add values for them, or use the %q interpolation mode to leave them for the job to expand at runtime.",
+					strings.Join(missing, ", "), interpolationPreserveRuntime),
+			)
+			return nil, diags
+		}
+
+	case interpolationPreserveRuntime:
+		escaped := envReferencePattern.ReplaceAllStringFunc(unsignedSteps, func(reference string) string {
+			if reference == "$$" || known(strings.TrimLeft(reference, "${")) {
+				return reference
+			}
+			return "$" + reference
+		})
+
+		var err error
+		p, err = pipeline.Parse(strings.NewReader(escaped))
+		if err != nil {
+			diags.AddError("Unable to parse pipeline steps", err.Error())
+			return nil, diags
+		}
+	}
+
+	interpolationEnv := make(mapInterpolationEnv, len(interpolation.Env))
+	for name, value := range interpolation.Env {
+		interpolationEnv[name] = value
+	}
+	if err := p.Interpolate(interpolationEnv, false); err != nil {
+		diags.AddError("Unable to interpolate pipeline steps", err.Error())
+		return nil, diags
+	}
+
+	return p, diags
+}
+
+// selectJWKSKey parses a JWKS and returns the key with the given ID, or its only key if keyID is null
+func selectJWKSKey(jwksContents []byte, keyID types.String) (jwk.Key, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	jwks, err := jwk.Parse(jwksContents)
+	if err != nil {
+		diags.AddError("Unable to parse JWKS", err.Error())
+		return nil, diags
+	}
+
+	if keyID.IsNull() {
+		if jwks.Len() != 1 {
+			diags.AddError(
+				"Cannot find key",
+				"JWKS does not contain exactly one key, but no key ID was specified",
+			)
+			return nil, diags
+		}
+		key, _ := jwks.Key(0)
+		return key, diags
+	}
+
+	key, ok := jwks.LookupKeyID(keyID.ValueString())
+	if !ok {
+		diags.AddError(
+			"Cannot find key",
+			fmt.Sprintf("The key with ID %q was not found in the JWKS", keyID.ValueString()),
+		)
+		return nil, diags
+	}
+	return key, diags
+}
+
+// stepVerification is the result of verifying the signature of a single command step
+type stepVerification struct {
+	// Path locates the step in the pipeline, for example steps[1].steps[0] for the first step of a group
+	Path     string
+	Key      string
+	Label    string
+	Verified bool
+	// Error describes why the step failed verification
+	Error string
+}
+
+// verifyPipelineSteps verifies the signature of every command step of a pipeline in YAML format against a public
+// JWKS. Steps that fail verification are reported in the results rather than as diagnostics.
+func verifyPipelineSteps(
+	ctx context.Context,
+	signedSteps, repository string,
+	jwksContents []byte,
+) ([]stepVerification, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	jwks, err := jwk.Parse(jwksContents)
+	if err != nil {
+		diags.AddError("Unable to parse JWKS", err.Error())
+		return nil, diags
+	}
+
+	p, err := pipeline.Parse(strings.NewReader(signedSteps))
+	if err != nil {
+		diags.AddError("Unable to parse pipeline steps", err.Error())
+		return nil, diags
+	}
+
+	results := make([]stepVerification, 0, len(p.Steps))
+	verifySteps(ctx, p.Steps, "steps", repository, jwks, p.Env.ToMap(), &results)
+	return results, diags
+}
+
+func verifySteps(
+	ctx context.Context,
+	steps pipeline.Steps,
+	path, repository string,
+	jwks jwk.Set,
+	env map[string]string,
+	results *[]stepVerification,
+) {
+	for i, step := range steps {
+		stepPath := fmt.Sprintf("%s[%d]", path, i)
+
+		switch step := step.(type) {
+		case *pipeline.CommandStep:
+			result := stepVerification{Path: stepPath, Key: step.Key, Label: step.Label}
+			if step.Signature == nil {
+				result.Error = "step is not signed"
+			} else {
+				stepWithInvariants := &signature.CommandStepWithInvariants{
+					CommandStep:   *step,
+					RepositoryURL: repository,
+				}
+				if err := signature.Verify(ctx, step.Signature, jwks, stepWithInvariants, signature.WithEnv(env)); err != nil {
+					result.Error = err.Error()
+				} else {
+					result.Verified = true
+				}
+			}
+			*results = append(*results, result)
+
+		case *pipeline.GroupStep:
+			verifySteps(ctx, step.Steps, stepPath+".steps", repository, jwks, env, results)
+
+		case *pipeline.UnknownStep:
+			// an unknown step may hide a command step that cannot be verified, so it is never trusted
+			*results = append(*results, stepVerification{
+				Path:  stepPath,
+				Error: "step is of an unknown type and cannot be verified",
+			})
+		}
+	}
+}
+
+// randomizedSigningAlgorithms produce a different signature each time the same steps are signed
+var randomizedSigningAlgorithms = map[jwa.SignatureAlgorithm]bool{
+	jwa.ES256: true,
+	jwa.ES384: true,
+	jwa.ES512: true,
+	jwa.PS256: true,
+	jwa.PS384: true,
+	jwa.PS512: true,
+}
+
+// pipelineSigningAlgorithms are the algorithms pipeline signing keys can be generated for
+var pipelineSigningAlgorithms = []string{
+	jwa.EdDSA.String(),
+	jwa.ES256.String(),
+	jwa.ES384.String(),
+	jwa.ES512.String(),
+	jwa.RS256.String(),
+	jwa.PS256.String(),
+}
+
+// generateSigningKeyPair generates a key pair for signing pipelines, returning the private and public key sets in JSON
+// format. If keyID is empty the key's RFC 7638 thumbprint is used as its ID.
+func generateSigningKeyPair(alg jwa.SignatureAlgorithm, keyID string) (privateJWKS, publicJWKS []byte, err error) {
+	var raw any
+	switch alg {
+	case jwa.EdDSA:
+		_, raw, err = ed25519.GenerateKey(rand.Reader)
+	case jwa.ES256:
+		raw, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jwa.ES384:
+		raw, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case jwa.ES512:
+		raw, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case jwa.RS256, jwa.PS256:
+		raw, err = rsa.GenerateKey(rand.Reader, 2048)
+	default:
+		return nil, nil, fmt.Errorf("unsupported algorithm: %s", alg)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate %s key: %w", alg, err)
+	}
+
+	return newSigningKeyPair(raw, alg, keyID)
+}
+
+// newSigningKeyPair creates the private and public key sets in JSON format for a raw private key. If keyID is empty
+// the key's RFC 7638 thumbprint is used as its ID.
+func newSigningKeyPair(raw any, alg jwa.SignatureAlgorithm, keyID string) (privateJWKS, publicJWKS []byte, err error) {
+	privateKey, err := jwk.FromRaw(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create key: %w", err)
+	}
+
+	if keyID == "" {
+		thumbprint, err := privateKey.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to calculate key thumbprint: %w", err)
+		}
+		keyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+
+	for name, value := range map[string]any{
+		jwk.AlgorithmKey: alg,
+		jwk.KeyIDKey:     keyID,
+		jwk.KeyUsageKey:  jwk.ForSignature,
+	} {
+		if err := privateKey.Set(name, value); err != nil {
+			return nil, nil, fmt.Errorf("failed to set key attribute %s: %w", name, err)
+		}
+	}
+
+	publicKey, err := jwk.PublicKeyOf(privateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+
+	privateJWKS, err = marshalJWKS(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicJWKS, err = marshalJWKS(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privateJWKS, publicJWKS, nil
+}
+
+func marshalJWKS(key jwk.Key) ([]byte, error) {
+	set := jwk.NewSet()
+	if err := set.AddKey(key); err != nil {
+		return nil, fmt.Errorf("failed to add key to set: %w", err)
+	}
+
+	contents, err := json.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JWKS: %w", err)
+	}
+	return contents, nil
+}
@@ -0,0 +1,109 @@
+package buildkite
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/youmark/pkcs8"
+)
+
+// errPrivateKeyPassword is returned when an encrypted private key cannot be decrypted with the password given
+var errPrivateKeyPassword = errors.New("the private key is encrypted and the password is missing or incorrect")
+
+// parsePrivateKeyPEM parses the first private key in PEM format, which may be a PKCS#8, SEC1 or PKCS#1 key. Keys
+// encrypted with PKCS#8 PBES2 or legacy PEM encryption are decrypted with the password. Errors never include the key.
+func parsePrivateKeyPEM(contents []byte, password string) (any, error) {
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, errors.New("no PEM data was found")
+	}
+
+	der := block.Bytes
+	//nolint:staticcheck // legacy encrypted PEM is insecure, but is still produced by common tools
+	if x509.IsEncryptedPEMBlock(block) {
+		if password == "" {
+			return nil, errPrivateKeyPassword
+		}
+		var err error
+		//nolint:staticcheck // see above
+		if der, err = x509.DecryptPEMBlock(block, []byte(password)); err != nil {
+			return nil, errPrivateKeyPassword
+		}
+	}
+
+	switch block.Type {
+	case "ENCRYPTED PRIVATE KEY":
+		if password == "" {
+			return nil, errPrivateKeyPassword
+		}
+		key, _, err := pkcs8.ParsePrivateKey(der, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt the PKCS#8 private key: %w", err)
+		}
+		return key, nil
+	case "PRIVATE KEY":
+		return parsePKCS8PrivateKey(der)
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(der)
+		if err != nil {
+			return nil, errors.New("unable to parse the SEC1 EC private key")
+		}
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(der)
+		if err != nil {
+			return nil, errors.New("unable to parse the PKCS#1 RSA private key")
+		}
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported PEM block type %q, expected a private key", block.Type)
+}
+
+func parsePKCS8PrivateKey(der []byte) (any, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, errors.New("unable to parse the PKCS#8 private key")
+	}
+	return key, nil
+}
+
+// inferSigningAlgorithm returns the signature algorithm for a private key. Ed25519 and EC keys only support a single
+// algorithm, RSA keys default to RS256 as its signatures are deterministic.
+func inferSigningAlgorithm(key any, configured string) (jwa.SignatureAlgorithm, error) {
+	var inferred jwa.SignatureAlgorithm
+	switch key := key.(type) {
+	case ed25519.PrivateKey:
+		inferred = jwa.EdDSA
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			inferred = jwa.ES256
+		case elliptic.P384():
+			inferred = jwa.ES384
+		case elliptic.P521():
+			inferred = jwa.ES512
+		default:
+			return "", fmt.Errorf("unsupported EC curve %s", key.Curve.Params().Name)
+		}
+	case *rsa.PrivateKey:
+		if configured == jwa.RS256.String() || configured == jwa.PS256.String() {
+			return jwa.SignatureAlgorithm(configured), nil
+		}
+		inferred = jwa.RS256
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	if configured != "" && configured != inferred.String() {
+		return "", fmt.Errorf("the %s algorithm cannot be used with this key, which requires %s", configured, inferred)
+	}
+	return inferred, nil
+}
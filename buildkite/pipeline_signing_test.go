@@ -0,0 +1,146 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/go-pipeline"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+func TestGenerateSigningKeyPair(t *testing.T) {
+	for _, alg := range pipelineSigningAlgorithms {
+		t.Run(alg, func(t *testing.T) {
+			privateJWKS, publicJWKS, err := generateSigningKeyPair(jwa.SignatureAlgorithm(alg), "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			privateKey, diags := selectJWKSKey(privateJWKS, types.StringNull())
+			if diags.HasError() {
+				t.Fatalf("unable to select private key: %v", diags)
+			}
+			publicKey, diags := selectJWKSKey(publicJWKS, types.StringNull())
+			if diags.HasError() {
+				t.Fatalf("unable to select public key: %v", diags)
+			}
+
+			if privateKey.KeyID() == "" || privateKey.KeyID() != publicKey.KeyID() {
+				t.Errorf("expected matching key IDs, got %q and %q", privateKey.KeyID(), publicKey.KeyID())
+			}
+			if publicKey.Algorithm().String() != alg {
+				t.Errorf("expected algorithm %s, got %s", alg, publicKey.Algorithm())
+			}
+			if _, err := publicKey.PublicKey(); err != nil {
+				t.Errorf("expected a public key: %v", err)
+			}
+
+			steps, diags := signPipelineSteps(context.Background(), "steps:\n- command: make test\n", "steps", "my-repo", privateJWKS, types.StringNull(), stepsInterpolation{})
+			if diags.HasError() {
+				t.Fatalf("unable to sign steps: %v", diags)
+			}
+
+			results, diags := verifyPipelineSteps(context.Background(), steps, "my-repo", publicJWKS)
+			if diags.HasError() {
+				t.Fatalf("unable to verify steps: %v", diags)
+			}
+			if len(results) != 1 || !results[0].Verified {
+				t.Errorf("expected the step to be verified, got %+v", results)
+			}
+		})
+	}
+
+	t.Run("with a key ID", func(t *testing.T) {
+		_, publicJWKS, err := generateSigningKeyPair(jwa.EdDSA, "my-key")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, diags := selectJWKSKey(publicJWKS, types.StringValue("my-key")); diags.HasError() {
+			t.Errorf("expected the key to have the given ID: %v", diags)
+		}
+	})
+
+	t.Run("with an unsupported algorithm", func(t *testing.T) {
+		if _, _, err := generateSigningKeyPair(jwa.HS256, ""); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestSignPipelineStepsInterpolation(t *testing.T) {
+	privateJWKS, publicJWKS, err := generateSigningKeyPair(jwa.EdDSA, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	steps := heredoc.Doc(`
+		env:
+		  IMAGE: "ruby:${RUBY_VERSION}"
+		steps:
+		- command: 'docker run $IMAGE make test BRANCH=$BUILDKITE_BRANCH COMMIT=${BUILDKITE_COMMIT:-HEAD} PRICE=$$5'
+	`)
+
+	testCases := map[string]struct {
+		interpolation stepsInterpolation
+		command       string
+		err           string
+	}{
+		"rejected by default": {
+			interpolation: stepsInterpolation{},
+			err:           "Environment interpolations are not allowed",
+		},
+		"resolved with env": {
+			interpolation: stepsInterpolation{
+				Mode: interpolationResolveWithEnv,
+				Env:  map[string]string{"RUBY_VERSION": "3.3", "BUILDKITE_BRANCH": "main", "BUILDKITE_COMMIT": "abc123"},
+			},
+			command: "docker run ruby:3.3 make test BRANCH=main COMMIT=abc123 PRICE=$5",
+		},
+		"resolved with missing values": {
+			interpolation: stepsInterpolation{
+				Mode: interpolationResolveWithEnv,
+				Env:  map[string]string{"RUBY_VERSION": "3.3"},
+			},
+			err: "$BUILDKITE_BRANCH, $BUILDKITE_COMMIT",
+		},
+		"runtime variables preserved": {
+			interpolation: stepsInterpolation{
+				Mode: interpolationPreserveRuntime,
+				Env:  map[string]string{"RUBY_VERSION": "3.3"},
+			},
+			command: "docker run ruby:3.3 make test BRANCH=$BUILDKITE_BRANCH COMMIT=${BUILDKITE_COMMIT:-HEAD} PRICE=$5",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			signedSteps, diags := signPipelineSteps(context.Background(), steps, "steps", "my-repo", privateJWKS, types.StringNull(), testCase.interpolation)
+			if testCase.err != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), testCase.err) {
+					t.Fatalf("expected an error containing %q, got %v", testCase.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			p, err := pipeline.Parse(strings.NewReader(signedSteps))
+			if err != nil {
+				t.Fatalf("unable to parse signed steps: %v", err)
+			}
+			if command := p.Steps[0].(*pipeline.CommandStep).Command; command != testCase.command {
+				t.Errorf("expected command %q, got %q", testCase.command, command)
+			}
+
+			results, diags := verifyPipelineSteps(context.Background(), signedSteps, "my-repo", publicJWKS)
+			if diags.HasError() || !results[0].Verified {
+				t.Errorf("expected the step to be verified, got %+v %v", results, diags)
+			}
+		})
+	}
+}
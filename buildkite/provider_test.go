@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	genqlient "github.com/Khan/genqlient/graphql"
+	"github.com/buildkite/terraform-provider-buildkite/internal/fakebuildkite"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/shurcooL/graphql"
@@ -17,7 +18,29 @@ var (
 	organizationID   string
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	// BUILDKITE_FAKE_API runs the acceptance tests against an in-memory stand-in for the Buildkite API rather than a
+	// real organization. The provider picks up the server through the same environment variables a user would set.
+	if os.Getenv("BUILDKITE_FAKE_API") != "" {
+		server := fakebuildkite.NewServer("terraform-provider-buildkite", "fake-api-token")
+		os.Setenv("BUILDKITE_ORGANIZATION_SLUG", server.Organization)
+		os.Setenv("BUILDKITE_API_TOKEN", server.Token)
+		os.Setenv("BUILDKITE_GRAPHQL_URL", server.GraphQLURL())
+		os.Setenv("BUILDKITE_REST_URL", server.URL)
+		// used by the registry tests to check for leftover registries
+		os.Setenv("BUILDKITE_API_URL", server.URL)
+
+		setupTestClients()
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
+	}
+
+	setupTestClients()
+	os.Exit(m.Run())
+}
+
+func setupTestClients() {
 	rt := http.DefaultTransport
 	header := make(http.Header)
 	header.Set("Authorization", "Bearer "+os.Getenv("BUILDKITE_API_TOKEN"))
@@ -28,9 +51,35 @@ func init() {
 		Transport: rt,
 	}
 
-	graphqlClient = graphql.NewClient(defaultGraphqlEndpoint, httpClient)
-	genqlientGraphql = genqlient.NewClient(defaultGraphqlEndpoint, httpClient)
-	organizationID, _ = GetOrganizationID(getenv("BUILDKITE_ORGANIZATION_SLUG"), graphqlClient)
+	graphqlURL := defaultGraphqlEndpoint
+	if v, ok := os.LookupEnv("BUILDKITE_GRAPHQL_URL"); ok {
+		graphqlURL = v
+	}
+
+	graphqlClient = graphql.NewClient(graphqlURL, httpClient)
+	genqlientGraphql = genqlient.NewClient(graphqlURL, newGraphQLDoer(httpClient))
+	organizationID, _ = GetOrganizationID(getenv("BUILDKITE_ORGANIZATION_SLUG"), genqlientGraphql)
+}
+
+// fakeTestClient returns an in-memory stand-in for the Buildkite API and a client for it, for tests that call the
+// API without Terraform
+func fakeTestClient(t *testing.T) (*fakebuildkite.Server, *Client) {
+	t.Helper()
+
+	server := fakebuildkite.NewServer("fake-test", "fake-test-token")
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&clientConfig{
+		org:        server.Organization,
+		apiToken:   server.Token,
+		graphqlURL: server.GraphQLURL(),
+		restURL:    server.URL,
+		userAgent:  "testing",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
 }
 
 func protoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
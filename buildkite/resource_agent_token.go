@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc"
+	custom_modifier "github.com/buildkite/terraform-provider-buildkite/internal/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,6 +31,7 @@ type agentTokenStateModel struct {
 	Id          types.String `tfsdk:"id"`
 	Token       types.String `tfsdk:"token"`
 	Uuid        types.String `tfsdk:"uuid"`
+	StoreToken  types.Bool   `tfsdk:"store_token"`
 }
 
 type agentTokenResource struct {
@@ -85,8 +89,12 @@ func (at *agentTokenResource) Create(ctx context.Context, req resource.CreateReq
 
 	state.Description = types.StringPointerValue(r.AgentTokenCreate.AgentTokenEdge.Node.Description)
 	state.Id = types.StringValue(r.AgentTokenCreate.AgentTokenEdge.Node.Id)
-	state.Token = types.StringValue(r.AgentTokenCreate.TokenValue)
+	state.Token = types.StringNull()
+	if plan.StoreToken.ValueBool() {
+		state.Token = types.StringValue(r.AgentTokenCreate.TokenValue)
+	}
 	state.Uuid = types.StringValue(r.AgentTokenCreate.AgentTokenEdge.Node.Uuid)
+	state.StoreToken = plan.StoreToken
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -115,12 +123,16 @@ func (at *agentTokenResource) Delete(ctx context.Context, req resource.DeleteReq
 			"Revoked by Terraform",
 		)
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to revoke agent token",
-			fmt.Sprintf("Unable to revoke agent token: %s", err.Error()),
+			fmt.Sprintf("Unable to revoke agent token: %s", describeError(err)),
 		)
 		return
 	}
@@ -158,9 +170,12 @@ func (at *agentTokenResource) Read(ctx context.Context, req resource.ReadRequest
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Agent token", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read agent token",
-			fmt.Sprintf("Unable to read agent token: %s", err.Error()),
+			fmt.Sprintf("Unable to read agent token: %s", describeError(err)),
 		)
 	}
 
@@ -174,6 +189,7 @@ func (at *agentTokenResource) Read(ctx context.Context, req resource.ReadRequest
 	state.Id = types.StringValue(r.AgentToken.Id)
 	state.Token = plan.Token // token is never returned after creation so use the existing value in state
 	state.Uuid = types.StringValue(r.AgentToken.Uuid)
+	state.StoreToken = storeTokenFromState(plan.StoreToken)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -199,20 +215,62 @@ func (agentTokenResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"token": resource_schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The token value used by an agent to register with the API.",
+				MarkdownDescription: "The token value used by an agent to register with the API. Null when `store_token` is `false`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					custom_modifier.NullUnless("store_token"),
 				},
 			},
 			"uuid": resource_schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The UUID of the agent token.",
 			},
+			"store_token": storeTokenAttribute(),
 		},
 	}
 }
 
+// Update only handles changes to store_token, as every other attribute requires a new agent token
 func (agentTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Cannot update an agent token", "A new agent token must be created")
-	panic("cannot update an agent token")
+	var plan, state agentTokenStateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.StoreToken = plan.StoreToken
+	if !plan.StoreToken.ValueBool() {
+		state.Token = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// storeTokenAttribute is the schema of the store_token attribute shared by the agent token resources. A token that was
+// not stored can't be read back, so storing it again requires a new token.
+func storeTokenAttribute() resource_schema.BoolAttribute {
+	return resource_schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+		MarkdownDescription: "Whether to store the token value in state. When `false` only the ID and UUID of the token are kept, " +
+			"and the value can be read with the ephemeral resource of the same name. Changing this to `true` creates a new token.",
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = !req.StateValue.IsNull() && !req.StateValue.ValueBool() && req.PlanValue.ValueBool()
+			}, "Storing the token requires a new token.", "Storing the token requires a new token."),
+		},
+	}
+}
+
+// storeTokenFromState defaults store_token for resources created before the attribute existed, which always stored
+// the token
+func storeTokenFromState(value types.Bool) types.Bool {
+	if value.IsNull() {
+		return types.BoolValue(true)
+	}
+	return value
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -110,6 +111,59 @@ func TestAccBuildkiteAgentToken(t *testing.T) {
 			},
 		})
 	})
+	// Confirm that the token value can be kept out of state, and that storing it again creates a new token
+	t.Run("stores only the agent token uuid", func(t *testing.T) {
+		randName := acctest.RandString(10)
+		config := func(storeToken bool) string {
+			return basic(randName) + fmt.Sprintf(`
+			resource "buildkite_agent_token" "uuid_only" {
+				description = "Acceptance Test %s"
+				store_token = %t
+			}
+			`, randName, storeToken)
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckAgentTokenResourceDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config(false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("buildkite_agent_token.uuid_only", "uuid"),
+						resource.TestCheckResourceAttr("buildkite_agent_token.uuid_only", "store_token", "false"),
+						resource.TestCheckNoResourceAttr("buildkite_agent_token.uuid_only", "token"),
+						resource.TestCheckResourceAttr("buildkite_agent_token.foobar", "store_token", "true"),
+						resource.TestCheckResourceAttrSet("buildkite_agent_token.foobar", "token"),
+					),
+				},
+				{
+					RefreshState: true,
+					PlanOnly:     true,
+					Check:        resource.TestCheckNoResourceAttr("buildkite_agent_token.uuid_only", "token"),
+				},
+				{
+					Config: config(true),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_agent_token.uuid_only", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("buildkite_agent_token.uuid_only", "token"),
+				},
+				{
+					Config: config(false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_agent_token.uuid_only", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.TestCheckNoResourceAttr("buildkite_agent_token.uuid_only", "token"),
+				},
+			},
+		})
+	})
 }
 
 func testAccCheckAgentTokenExists(resourceName string, resourceToken *AgentTokenNode) resource.TestCheckFunc {
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,9 @@ type clusterResourceModel struct {
 	Emoji       types.String `tfsdk:"emoji"`
 	Color       types.String `tfsdk:"color"`
 	UUID        types.String `tfsdk:"uuid"`
+
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
 }
 
 func newClusterResource() resource.Resource {
@@ -87,6 +91,8 @@ func (c *clusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				MarkdownDescription: "A color representation of the Cluster. Accepts hex codes, eg #BADA55.",
 			},
+			"deletion_protection": deletionProtectionAttribute("cluster"),
+			"deletion_policy":     deletionPolicyAttribute("cluster"),
 		},
 	}
 }
@@ -168,9 +174,12 @@ func (c *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Cluster", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read Cluster",
-			fmt.Sprintf("Unable to read Cluster: %s", err.Error()),
+			fmt.Sprintf("Unable to read Cluster: %s", describeError(err)),
 		)
 		return
 	}
@@ -184,6 +193,7 @@ func (c *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 			return
 		}
 		updateClusterResourceState(&state, *clusterNode)
+		setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
 		resp.Diagnostics.AddWarning(
@@ -250,6 +260,10 @@ func (c *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if !checkDeletion("cluster", state.DeletionProtection, state.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
 	timeout, diags := c.client.timeouts.Delete(ctx, DefaultTimeout)
 
 	resp.Diagnostics.Append(diags...)
@@ -264,19 +278,66 @@ func (c *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 			_, err = deleteCluster(ctx, c.client.genqlient, *org, state.ID.ValueString())
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Cluster",
-			fmt.Sprintf("Unable to delete Cluster: %s", err.Error()),
+			fmt.Sprintf("Unable to delete Cluster: %s", describeError(err)),
 		)
 		return
 	}
 }
 
 func (c *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "Cluster") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// otherwise the import identifier is the name of the cluster
+	cluster, err := findClusterByName(ctx, c.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import Cluster",
+			fmt.Sprintf("Unable to read Clusters: %s", describeError(err)),
+		)
+		return
+	}
+	if cluster == nil {
+		resp.Diagnostics.AddError("Unable to import Cluster", fmt.Sprintf("Could not find cluster with name %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cluster.Id)...)
+}
+
+// findClusterByName pages through the clusters of the organization for the one with the given name, returning nil
+// when there is none
+func findClusterByName(ctx context.Context, client *Client, name string) (*ClusterFields, error) {
+	var cursor *string
+	for {
+		log.Printf("Searching for cluster with name %s ...", name)
+		r, err := getClusterByName(ctx, client.genqlient, client.organization, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range r.Organization.Clusters.Edges {
+			if cluster.Node.Name == name {
+				return &cluster.Node.ClusterFields, nil
+			}
+		}
+
+		if !r.Organization.Clusters.PageInfo.HasNextPage {
+			return nil, nil
+		}
+		cursor = &r.Organization.Clusters.PageInfo.EndCursor
+	}
 }
 
 func updateClusterResourceState(state *clusterResourceModel, res getNodeNodeCluster) {
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	custom_modifier "github.com/buildkite/terraform-provider-buildkite/internal/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,6 +28,7 @@ type clusterAgentTokenResourceModel struct {
 	ClusterId          types.String `tfsdk:"cluster_id"`
 	ClusterUuid        types.String `tfsdk:"cluster_uuid"`
 	AllowedIpAddresses types.List   `tfsdk:"allowed_ip_addresses"`
+	StoreToken         types.Bool   `tfsdk:"store_token"`
 }
 
 func newClusterAgentTokenResource() resource.Resource {
@@ -72,9 +74,10 @@ func (ct *clusterAgentToken) Schema(_ context.Context, _ resource.SchemaRequest,
 			"token": resource_schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The token value used by an agent to register with the API.",
+				MarkdownDescription: "The token value used by an agent to register with the API. Null when `store_token` is `false`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					custom_modifier.NullUnless("store_token"),
 				},
 			},
 			"cluster_id": resource_schema.StringAttribute{
@@ -97,6 +100,7 @@ func (ct *clusterAgentToken) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "A list of CIDR-notation IPv4 addresses from which agents can use this Cluster Agent Token." +
 					"If not set, all IP addresses are allowed (the same as setting 0.0.0.0/0).",
 			},
+			"store_token": storeTokenAttribute(),
 		},
 	}
 }
@@ -149,10 +153,14 @@ func (ct *clusterAgentToken) Create(ctx context.Context, req resource.CreateRequ
 	state.Id = types.StringValue(r.ClusterAgentTokenCreate.ClusterAgentToken.Id)
 	state.Uuid = types.StringValue(r.ClusterAgentTokenCreate.ClusterAgentToken.Uuid)
 	state.Description = types.StringValue(r.ClusterAgentTokenCreate.ClusterAgentToken.Description)
-	state.Token = types.StringValue(r.ClusterAgentTokenCreate.TokenValue)
+	state.Token = types.StringNull()
+	if plan.StoreToken.ValueBool() {
+		state.Token = types.StringValue(r.ClusterAgentTokenCreate.TokenValue)
+	}
 	state.ClusterId = types.StringValue(r.ClusterAgentTokenCreate.ClusterAgentToken.Cluster.Id)
 	state.ClusterUuid = types.StringValue(r.ClusterAgentTokenCreate.ClusterAgentToken.Cluster.Uuid)
 	state.AllowedIpAddresses = plan.AllowedIpAddresses
+	state.StoreToken = plan.StoreToken
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -188,9 +196,12 @@ func (ct *clusterAgentToken) Read(ctx context.Context, req resource.ReadRequest,
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Cluster Agent Token", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read Cluster Agent Tokens",
-			fmt.Sprintf("Unable to read Cluster Agent Tokens: %s", err.Error()),
+			fmt.Sprintf("Unable to read Cluster Agent Tokens: %s", describeError(err)),
 		)
 		return
 	}
@@ -199,6 +210,7 @@ func (ct *clusterAgentToken) Read(ctx context.Context, req resource.ReadRequest,
 		if edge.Node.Id == state.Id.ValueString() {
 			log.Printf("Found cluster Token with Description %s in cluster %s", edge.Node.Id, state.ClusterUuid.ValueString())
 			state.Description = types.StringValue(edge.Node.Description)
+			state.StoreToken = storeTokenFromState(state.StoreToken)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 			return
 		}
@@ -254,6 +266,10 @@ func (ct *clusterAgentToken) Update(ctx context.Context, req resource.UpdateRequ
 
 	state.Description = types.StringValue(r.ClusterAgentTokenUpdate.ClusterAgentToken.Description)
 	state.AllowedIpAddresses = plan.AllowedIpAddresses
+	state.StoreToken = plan.StoreToken
+	if !plan.StoreToken.ValueBool() {
+		state.Token = types.StringNull()
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -286,12 +302,16 @@ func (ct *clusterAgentToken) Delete(ctx context.Context, req resource.DeleteRequ
 			)
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to revoke Cluster Agent Token",
-			fmt.Sprintf("Unable to revoke Cluster Agent Token: %s", err.Error()),
+			fmt.Sprintf("Unable to revoke Cluster Agent Token: %s", describeError(err)),
 		)
 		return
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -171,6 +172,54 @@ func TestAccBuildkiteClusterAgentTokenResource(t *testing.T) {
 			},
 		})
 	})
+	t.Run("stores only the cluster agent token uuid", func(t *testing.T) {
+		clusterName := acctest.RandString(10)
+		tokenDesc := acctest.RandString(10)
+		config := func(storeToken bool) string {
+			return configBasic(clusterName, tokenDesc) + fmt.Sprintf(`
+			resource "buildkite_cluster_agent_token" "uuid_only" {
+				cluster_id = buildkite_cluster.cluster_test.id
+				description = "Acceptance Test %s"
+				store_token = %t
+			}
+			`, tokenDesc, storeToken)
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckClusterAgentTokenDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config(false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("buildkite_cluster_agent_token.uuid_only", "uuid"),
+						resource.TestCheckResourceAttr("buildkite_cluster_agent_token.uuid_only", "store_token", "false"),
+						resource.TestCheckNoResourceAttr("buildkite_cluster_agent_token.uuid_only", "token"),
+						resource.TestCheckResourceAttrSet("buildkite_cluster_agent_token.foobar", "token"),
+					),
+				},
+				{
+					Config: config(true),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_cluster_agent_token.uuid_only", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("buildkite_cluster_agent_token.uuid_only", "token"),
+				},
+				{
+					Config: config(false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_cluster_agent_token.uuid_only", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.TestCheckNoResourceAttr("buildkite_cluster_agent_token.uuid_only", "token"),
+				},
+			},
+		})
+	})
 }
 
 func testAccCheckClusterAgentTokenExists(resourceName string, ct *clusterAgentTokenResourceModel) resource.TestCheckFunc {
@@ -93,12 +93,16 @@ func (c *clusterDefaultQueueResource) Delete(ctx context.Context, req resource.D
 			_, err = removeClusterDefaultQueue(ctx, c.client.genqlient, *org, state.ClusterId.ValueString())
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to remove default queue",
-			fmt.Sprintf("Unable to remove default queue: %s", err.Error()),
+			fmt.Sprintf("Unable to remove default queue: %s", describeError(err)),
 		)
 		return
 	}
@@ -143,9 +147,12 @@ func (c *clusterDefaultQueueResource) Read(ctx context.Context, req resource.Rea
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Cluster default queue", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read cluster",
-			fmt.Sprintf("Unable to read cluster: %s", err.Error()),
+			fmt.Sprintf("Unable to read cluster: %s", describeError(err)),
 		)
 		return
 	}
@@ -180,7 +187,26 @@ func (c *clusterDefaultQueueResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (c *clusterDefaultQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "Cluster") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// otherwise the import identifier is the name of the cluster
+	cluster, err := findClusterByName(ctx, c.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import Cluster default queue",
+			fmt.Sprintf("Unable to read Clusters: %s", describeError(err)),
+		)
+		return
+	}
+	if cluster == nil {
+		resp.Diagnostics.AddError("Unable to import Cluster default queue", fmt.Sprintf("Could not find cluster with name %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cluster.Id)...)
 }
 
 // Schema implements resource.Resource.
@@ -49,6 +49,13 @@ func TestAccBuildkiteClusterDefaultQueueResource(t *testing.T) {
 						return err
 					},
 				},
+				{
+					// import the default queue by the name of the cluster
+					ResourceName:      "buildkite_cluster_default_queue.cluster",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_cluster.cluster.name"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 const (
@@ -37,6 +38,9 @@ const (
 	LinuxARM64InstanceXLarge string = "LINUX_ARM64_16X64"
 )
 
+// defaultDrainNote is the note dispatch is paused with while a queue is drained, if none is configured
+const defaultDrainNote = "Draining before the queue is deleted by Terraform"
+
 var MacInstanceShapes = []string{
 	MacInstanceSmall,
 	MacInstanceMedium,
@@ -64,6 +68,20 @@ type clusterQueueResourceModel struct {
 	Description    types.String              `tfsdk:"description"`
 	DispatchPaused types.Bool                `tfsdk:"dispatch_paused"`
 	HostedAgents   *hostedAgentResourceModel `tfsdk:"hosted_agents"`
+	DrainOnDestroy *drainOnDestroyModel      `tfsdk:"drain_on_destroy"`
+
+	DispatchPausedNote types.String `tfsdk:"dispatch_paused_note"`
+	DispatchPausedAt   types.String `tfsdk:"dispatch_paused_at"`
+	DispatchPausedBy   types.String `tfsdk:"dispatch_paused_by"`
+
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
+}
+
+type drainOnDestroyModel struct {
+	Note                types.String `tfsdk:"note"`
+	StopAgents          types.Bool   `tfsdk:"stop_agents"`
+	IgnoreScheduledJobs types.Bool   `tfsdk:"ignore_scheduled_jobs"`
 }
 
 type hostedAgentResourceModel struct {
@@ -84,6 +102,11 @@ type clusterQueueResource struct {
 	client *Client
 }
 
+var (
+	_ resource.ResourceWithValidateConfig = &clusterQueueResource{}
+	_ resource.ResourceWithModifyPlan     = &clusterQueueResource{}
+)
+
 func newClusterQueueResource() resource.Resource {
 	return &clusterQueueResource{}
 }
@@ -149,6 +172,45 @@ func (clusterQueueResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The dispatch state of a cluster queue.",
 				Default:             booldefault.StaticBool(false),
 			},
+			"dispatch_paused_note": resource_schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "A note explaining why dispatch is paused, shown with the queue in Buildkite. " +
+					"Can only be set when `dispatch_paused` is `true`. A pause made outside of Terraform with a different note is treated as drift. " +
+					"The note cannot be changed while dispatch stays paused; resume dispatch and then pause it again with the new note.",
+			},
+			"dispatch_paused_at": resource_schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When dispatch was paused, if it is.",
+			},
+			"dispatch_paused_by": resource_schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the user that paused dispatch, if it is paused.",
+			},
+			"drain_on_destroy": resource_schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Drain the queue before it is deleted. Dispatch is paused, and the queue is only deleted once " +
+					"the jobs already assigned to or running on it have finished, failing if that takes longer than the delete timeout. " +
+					"Scheduled jobs are not dispatched while the queue is paused, so they are not waited for, and the drain fails " +
+					"if any are left rather than stranding them, unless `ignore_scheduled_jobs` is set. " +
+					"If the drain fails, dispatch is resumed unless it was already paused.",
+				Attributes: map[string]resource_schema.Attribute{
+					"note": resource_schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The note dispatch is paused with while the queue drains. Only used if dispatch is not already paused.",
+					},
+					"stop_agents": resource_schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether to gracefully stop the agents connected to the queue once its jobs have finished. Defaults to `false`.",
+					},
+					"ignore_scheduled_jobs": resource_schema.BoolAttribute{
+						Optional: true,
+						MarkdownDescription: "Whether to delete the queue even though jobs are still scheduled on it, which are never run, " +
+							"with a warning instead of an error. Defaults to `false`.",
+					},
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute("cluster queue"),
+			"deletion_policy":     deletionPolicyAttribute("cluster queue"),
 			"hosted_agents": resource_schema.SingleNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "Control the settings for the Buildkite hosted agents.",
@@ -199,10 +261,10 @@ func (clusterQueueResource) Schema(ctx context.Context, req resource.SchemaReque
 					},
 					"instance_shape": resource_schema.StringAttribute{
 						Required: true,
+						MarkdownDescription: "The instance shape of the hosted agents, which must suit the platform. " +
+							"The shapes available are listed by the `buildkite_hosted_agent_catalog` data source.",
 						Validators: []validator.String{
-							stringvalidator.OneOf(
-								append(MacInstanceShapes, LinuxInstanceShapes...)...,
-							),
+							&instanceShapeValidator{},
 						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
@@ -214,6 +276,83 @@ func (clusterQueueResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+// ValidateConfig checks a note is only given for a paused queue, as the API only keeps the note while it is paused
+func (cq *clusterQueueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var paused types.Bool
+	var note types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dispatch_paused"), &paused)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dispatch_paused_note"), &note)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !note.IsNull() && !paused.IsUnknown() && !paused.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dispatch_paused_note"),
+			"Dispatch is not paused",
+			"dispatch_paused_note can only be set when dispatch_paused is true.",
+		)
+	}
+}
+
+// ModifyPlan checks the instance shape of hosted agents is in the catalog, and keeps when and by whom dispatch was
+// paused unless the queue is going to be paused or resumed, or paused again with a new note
+func (cq *clusterQueueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying the queue
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	cq.validateInstanceShape(ctx, req, resp)
+
+	// Dispatch can't be paused yet when creating the queue
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state clusterQueueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DispatchPaused.Equal(state.DispatchPaused) && plan.DispatchPausedNote.Equal(state.DispatchPausedNote) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dispatch_paused_at"), state.DispatchPausedAt)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dispatch_paused_by"), state.DispatchPausedBy)...)
+	}
+}
+
+// validateInstanceShape checks the planned instance shape against the hosted agent catalog, so shapes released since
+// this version of the provider can be used. The shape can't change in place, so it is only checked when it is new.
+func (cq *clusterQueueResource) validateInstanceShape(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planned, prior types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hosted_agents"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("hosted_agents"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	var hosted struct {
+		Mac           types.Object `tfsdk:"mac"`
+		Linux         types.Object `tfsdk:"linux"`
+		InstanceShape types.String `tfsdk:"instance_shape"`
+	}
+	resp.Diagnostics.Append(planned.As(ctx, &hosted, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || hosted.InstanceShape.IsUnknown() {
+		return
+	}
+	if shape, ok := prior.Attributes()["instance_shape"]; ok && shape.Equal(hosted.InstanceShape) {
+		return
+	}
+
+	shapes := hostedAgentInstanceShapes(ctx, cq.client)
+	resp.Diagnostics.Append(checkInstanceShape(shapes, hosted.InstanceShape.ValueString(), !hosted.Mac.IsNull(), !hosted.Linux.IsNull())...)
+}
+
 func (cq *clusterQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state clusterQueueResourceModel
 
@@ -281,19 +420,26 @@ func (cq *clusterQueueResource) Create(ctx context.Context, req resource.CreateR
 	state.ClusterUuid = types.StringValue(r.ClusterQueueCreate.ClusterQueue.Cluster.Uuid)
 	state.Key = types.StringValue(r.ClusterQueueCreate.ClusterQueue.Key)
 	state.Description = types.StringPointerValue(r.ClusterQueueCreate.ClusterQueue.Description)
+	state.DrainOnDestroy = plan.DrainOnDestroy
+	state.DeletionProtection = plan.DeletionProtection
+	state.DeletionPolicy = plan.DeletionPolicy
 
-	state.DispatchPaused = types.BoolValue(false) // Start with false, update below if needed
+	// Start unpaused, update below if needed
+	state.DispatchPaused = types.BoolValue(false)
+	state.DispatchPausedNote = types.StringNull()
+	state.DispatchPausedAt = types.StringNull()
+	state.DispatchPausedBy = types.StringNull()
 
 	// GraphQL API does not allow Cluster Queue to be created with Dispatch Paused
 	// so Pause Dispatch after creation if required
 	if plan.DispatchPaused.ValueBool() {
 		log.Printf("Pausing dispatch on cluster queue with key %s", plan.Key.ValueString())
-		err = cq.pauseDispatch(ctx, timeout, state, &resp.Diagnostics)
+		paused, err := cq.pauseDispatch(ctx, timeout, state, plan.DispatchPausedNote.ValueStringPointer(), &resp.Diagnostics)
 		if err != nil {
 			// Error is added to diagnostics within pauseDispatch
 			return
 		}
-		state.DispatchPaused = types.BoolValue(true)
+		setClusterQueueDispatchValues(&state, paused.ClusterQueuePauseDispatch.Queue.ClusterQueueDispatchValues)
 	}
 
 	if plan.HostedAgents != nil {
@@ -342,9 +488,12 @@ func (cq *clusterQueueResource) Read(ctx context.Context, req resource.ReadReque
 		log.Printf("Getting cluster queues for cluster %s ...", state.ClusterUuid.ValueString())
 		r, err = getClusterQueues(ctx, cq.client.genqlient, cq.client.organization, state.ClusterUuid.ValueString(), cursor)
 		if err != nil {
+			if removeIfNotFound(ctx, err, "Cluster Queue", resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Unable to read Cluster Queues",
-				fmt.Sprintf("Unable to read Cluster Queues: %s", err.Error()),
+				fmt.Sprintf("Unable to read Cluster Queues: %s", describeError(err)),
 			)
 			return
 		}
@@ -356,6 +505,7 @@ func (cq *clusterQueueResource) Read(ctx context.Context, req resource.ReadReque
 				log.Printf("Found cluster queue with ID %s in cluster %s", edge.Node.Id, state.ClusterUuid.ValueString())
 				// Update ClusterQueueResourceModel with Node values and append
 				updateClusterQueueResource(edge.Node, &state)
+				setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
 				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 				break
 			}
@@ -379,20 +529,71 @@ func (cq *clusterQueueResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (cq *clusterQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importComponents := strings.Split(req.ID, ",")
+	if id, clusterUuid, ok := strings.Cut(req.ID, ","); ok {
+		if id == "" || clusterUuid == "" || strings.Contains(clusterUuid, ",") {
+			addImportIDError(&resp.Diagnostics, req.ID, "id,cluster_uuid", "cluster_name/queue_key")
+			return
+		}
+
+		// Adding the cluster queue ID/cluster UUID to state for Read
+		log.Printf("Importing cluster queue %s ...", id)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_uuid"), clusterUuid)...)
+		return
+	}
+
+	// cluster names may contain a slash but queue keys cannot, so the key follows the last one
+	separator := strings.LastIndex(req.ID, "/")
+	if separator <= 0 || separator == len(req.ID)-1 {
+		addImportIDError(&resp.Diagnostics, req.ID, "id,cluster_uuid", "cluster_name/queue_key")
+		return
+	}
+	clusterName, key := req.ID[:separator], req.ID[separator+1:]
 
-	if len(importComponents) != 2 || importComponents[0] == "" || importComponents[1] == "" {
+	cluster, err := findClusterByName(ctx, cq.client, clusterName)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: id,cluster_uuid. Got: %q", req.ID),
+			"Unable to import Cluster Queue",
+			fmt.Sprintf("Unable to read Clusters: %s", describeError(err)),
 		)
 		return
 	}
+	if cluster == nil {
+		resp.Diagnostics.AddError("Unable to import Cluster Queue", fmt.Sprintf("Could not find cluster with name %q", clusterName))
+		return
+	}
+
+	var cursor *string
+	for {
+		log.Printf("Getting cluster queues for cluster %s ...", cluster.Uuid)
+		r, err := getClusterQueues(ctx, cq.client.genqlient, cq.client.organization, cluster.Uuid, cursor)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import Cluster Queue",
+				fmt.Sprintf("Unable to read Cluster Queues: %s", describeError(err)),
+			)
+			return
+		}
 
-	// Adding the cluster queue ID/cluster UUID to state for Read
-	log.Printf("Importing cluster queue %s ...", importComponents[0])
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importComponents[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_uuid"), importComponents[1])...)
+		for _, edge := range r.Organization.Cluster.Queues.Edges {
+			if edge.Node.Key == key {
+				log.Printf("Importing cluster queue %s ...", edge.Node.Id)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), edge.Node.Id)...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_uuid"), cluster.Uuid)...)
+				return
+			}
+		}
+
+		if !r.Organization.Cluster.Queues.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &r.Organization.Cluster.Queues.PageInfo.EndCursor
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to import Cluster Queue",
+		fmt.Sprintf("Could not find queue with key %q in cluster %q", key, clusterName),
+	)
 }
 
 func (cq *clusterQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -465,7 +666,7 @@ func (cq *clusterQueueResource) Update(ctx context.Context, req resource.UpdateR
 	// Check the planned value against the current state value
 	// Planned to be true (changing from false to true)
 	if planDispatchPaused && !stateDispatchPaused {
-		if err := cq.pauseDispatch(ctx, timeout, state, &resp.Diagnostics); err != nil {
+		if _, err := cq.pauseDispatch(ctx, timeout, state, plan.DispatchPausedNote.ValueStringPointer(), &resp.Diagnostics); err != nil {
 			// Error added to diagnostics within pauseDispatch
 			return
 		}
@@ -479,6 +680,30 @@ func (cq *clusterQueueResource) Update(ctx context.Context, req resource.UpdateR
 		}
 	}
 
+	// Staying paused with a different note. The note can only be given when pausing, so pause again with the new note
+	// rather than resuming, which would let jobs be dispatched in between. The API may reject pausing a queue that is
+	// already paused, in which case the note can only be changed by resuming first
+	if planDispatchPaused && stateDispatchPaused && !plan.DispatchPausedNote.Equal(state.DispatchPausedNote) {
+		log.Printf("Changing the dispatch pause note for cluster queue %s", state.Key.ValueString())
+		_, err := pauseDispatchClusterQueue(ctx, cq.client.genqlient, state.Id.ValueString(), plan.DispatchPausedNote.ValueStringPointer())
+		if err != nil && isAlreadyPausedError(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dispatch_paused_note"),
+				"Unable to change Cluster Queue dispatch pause note",
+				fmt.Sprintf("The note of cluster queue %s cannot be changed while dispatch is paused. "+
+					"Resume dispatch by applying with dispatch_paused = false, then pause it again with the new note.", state.Key.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to pause Cluster Queue dispatch",
+				fmt.Sprintf("Unable to pause dispatch for cluster queue: %s", err.Error()),
+			)
+			return
+		}
+	}
+
 	r, err = updateClusterQueue(ctx,
 		cq.client.genqlient,
 		*org,
@@ -495,7 +720,10 @@ func (cq *clusterQueueResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	state.Description = types.StringPointerValue(r.ClusterQueueUpdate.ClusterQueue.Description)
-	state.DispatchPaused = types.BoolValue(r.ClusterQueueUpdate.ClusterQueue.DispatchPaused)
+	setClusterQueueDispatchValues(&state, r.ClusterQueueUpdate.ClusterQueue.ClusterQueueDispatchValues)
+	state.DrainOnDestroy = plan.DrainOnDestroy
+	state.DeletionProtection = plan.DeletionProtection
+	state.DeletionPolicy = plan.DeletionPolicy
 	if state.HostedAgents != nil {
 		state.HostedAgents = &hostedAgentResourceModel{
 			InstanceShape: types.StringValue(string(r.ClusterQueueUpdate.ClusterQueue.HostedAgents.InstanceShape.Name)),
@@ -525,7 +753,11 @@ func (cq *clusterQueueResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	_, deleteDiags := cq.client.timeouts.Delete(ctx, DefaultTimeout)
+	if !checkDeletion("cluster queue", plan.DeletionProtection, plan.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
+	timeout, deleteDiags := cq.client.timeouts.Delete(ctx, DefaultTimeout)
 	resp.Diagnostics.Append(deleteDiags...)
 
 	if resp.Diagnostics.HasError() {
@@ -538,16 +770,23 @@ func (cq *clusterQueueResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if plan.DrainOnDestroy != nil {
+		if err := cq.drain(ctx, timeout, plan, &resp.Diagnostics); err != nil {
+			// Error added to diagnostics within drain
+			return
+		}
+	}
+
 	log.Printf("Deleting cluster queue %s ...", plan.Id.ValueString())
 	_, err = deleteClusterQueue(ctx,
 		cq.client.genqlient,
 		*org,
 		plan.Id.ValueString(),
 	)
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Unable to delete Cluster Queue",
-			fmt.Sprintf("Unable to delete Cluster Queue: %s", err.Error()),
+			fmt.Sprintf("Unable to delete Cluster Queue: %s", describeError(err)),
 		)
 		return
 	}
@@ -556,11 +795,11 @@ func (cq *clusterQueueResource) Delete(ctx context.Context, req resource.DeleteR
 type hostedAgentValidator struct{}
 
 func (v hostedAgentValidator) Description(ctx context.Context) string {
-	return "validates platform and instance shape compatibility"
+	return "validates only one platform is configured"
 }
 
 func (v hostedAgentValidator) MarkdownDescription(ctx context.Context) string {
-	return "Validates that the instance shape is compatible with the selected platform"
+	return "Validates that only one of `mac` or `linux` is configured"
 }
 
 func (v hostedAgentValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
@@ -587,7 +826,6 @@ func (v hostedAgentValidator) ValidateObject(ctx context.Context, req validator.
 		return
 	}
 
-	shape := data.InstanceShape.ValueString()
 	hasMac := !data.Mac.IsNull() && !data.Mac.IsUnknown()
 	hasLinux := !data.Linux.IsNull() && !data.Linux.IsUnknown()
 
@@ -598,44 +836,78 @@ func (v hostedAgentValidator) ValidateObject(ctx context.Context, req validator.
 			"Invalid platform configuration",
 			"Only one platform (mac or linux) can be specified at a time",
 		)
+	}
+}
+
+// instanceShapeValidator checks an instance shape against the shapes known to the provider without reading the
+// catalog. Any other shape may have been added to the catalog since this version of the provider was released, so that
+// is only a warning and the catalog is checked when planning.
+type instanceShapeValidator struct{}
+
+func (v instanceShapeValidator) Description(ctx context.Context) string {
+	return "warns when the instance shape is not known to the provider"
+}
+
+func (v instanceShapeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v instanceShapeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
 
-	// Validate Mac shapes
-	if hasMac {
-		isValid := false
-		for _, validShape := range MacInstanceShapes {
-			if shape == validShape {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("instance_shape"),
-				"Invalid instance shape for Mac platform",
-				fmt.Sprintf("Instance shape %s is not valid for Mac platform. Valid shapes are: %v", shape, MacInstanceShapes),
-			)
-		}
+	shape := req.ConfigValue.ValueString()
+	known := slices.Concat(LinuxInstanceShapes, MacInstanceShapes)
+	if !slices.Contains(known, shape) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unknown instance shape",
+			fmt.Sprintf("Instance shape %s is not known to this version of the provider, so it will be checked against the hosted agent catalog when planning. Known shapes are: %v", shape, known),
+		)
 	}
+}
 
-	// Validate Linux shapes
-	if hasLinux {
-		isValid := false
-		for _, validShape := range LinuxInstanceShapes {
-			if shape == validShape {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("instance_shape"),
-				"Invalid instance shape for Linux platform",
-				fmt.Sprintf("Instance shape %s is not valid for Linux platform. Valid shapes are: %v", shape, LinuxInstanceShapes),
-			)
+// checkInstanceShape checks an instance shape is in the catalog and suits the platform of the hosted agents
+func checkInstanceShape(shapes []hostedAgentInstanceShapeModel, shape string, hasMac, hasLinux bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var all, mac, linux []string
+	for _, s := range shapes {
+		all = append(all, s.Name.ValueString())
+		switch s.MachineType.ValueString() {
+		case hostedAgentMachineTypeMacOS:
+			mac = append(mac, s.Name.ValueString())
+		case hostedAgentMachineTypeLinux:
+			linux = append(linux, s.Name.ValueString())
+		default:
+			// the platform of a shape that isn't named like the others can't be told, so it is allowed for either
+			mac = append(mac, s.Name.ValueString())
+			linux = append(linux, s.Name.ValueString())
 		}
 	}
+
+	attrPath := path.Root("hosted_agents").AtName("instance_shape")
+	switch {
+	case hasMac && !slices.Contains(mac, shape):
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid instance shape for Mac platform",
+			fmt.Sprintf("Instance shape %s is not valid for Mac platform. Valid shapes are: %v", shape, mac),
+		)
+	case hasLinux && !slices.Contains(linux, shape):
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid instance shape for Linux platform",
+			fmt.Sprintf("Instance shape %s is not valid for Linux platform. Valid shapes are: %v", shape, linux),
+		)
+	case !slices.Contains(all, shape):
+		diags.AddAttributeError(
+			attrPath,
+			"Unsupported instance shape",
+			fmt.Sprintf("Instance shape %s is not available for hosted agents. Valid shapes are: %v", shape, all),
+		)
+	}
+	return diags
 }
 
 func updateClusterQueueResource(clusterQueueNode getClusterQueuesOrganizationClusterQueuesClusterQueueConnectionEdgesClusterQueueEdgeNodeClusterQueue, cq *clusterQueueResourceModel) {
@@ -645,7 +917,7 @@ func updateClusterQueueResource(clusterQueueNode getClusterQueuesOrganizationClu
 	cq.Description = types.StringPointerValue(clusterQueueNode.Description)
 	cq.ClusterId = types.StringValue(clusterQueueNode.Cluster.Id)
 	cq.ClusterUuid = types.StringValue(clusterQueueNode.Cluster.Uuid)
-	cq.DispatchPaused = types.BoolValue(clusterQueueNode.DispatchPaused)
+	setClusterQueueDispatchValues(cq, clusterQueueNode.ClusterQueueDispatchValues)
 
 	if clusterQueueNode.Hosted {
 		cq.HostedAgents = &hostedAgentResourceModel{
@@ -664,9 +936,26 @@ func updateClusterQueueResource(clusterQueueNode getClusterQueuesOrganizationClu
 	}
 }
 
-func (cq *clusterQueueResource) pauseDispatch(ctx context.Context, timeout time.Duration, state clusterQueueResourceModel, diag *diag.Diagnostics) error {
+// setClusterQueueDispatchValues sets whether dispatch is paused, and when, by whom and why it was
+func setClusterQueueDispatchValues(cq *clusterQueueResourceModel, values ClusterQueueDispatchValues) {
+	cq.DispatchPaused = types.BoolValue(values.DispatchPaused)
+	cq.DispatchPausedNote = types.StringNull()
+	if values.DispatchPausedNote != nil && *values.DispatchPausedNote != "" {
+		cq.DispatchPausedNote = types.StringValue(*values.DispatchPausedNote)
+	}
+	cq.DispatchPausedAt = types.StringNull()
+	if values.DispatchPausedAt != nil {
+		cq.DispatchPausedAt = types.StringValue(values.DispatchPausedAt.Format(time.RFC3339))
+	}
+	cq.DispatchPausedBy = types.StringNull()
+	if values.DispatchPausedBy != nil {
+		cq.DispatchPausedBy = types.StringValue(values.DispatchPausedBy.Id)
+	}
+}
+
+func (cq *clusterQueueResource) pauseDispatch(ctx context.Context, timeout time.Duration, state clusterQueueResourceModel, note *string, diag *diag.Diagnostics) (*pauseDispatchClusterQueueResponse, error) {
 	log.Printf("Pausing dispatch for cluster queue %s", state.Key.ValueString())
-	_, err := pauseDispatchClusterQueue(ctx, cq.client.genqlient, state.Id.ValueString())
+	r, err := pauseDispatchClusterQueue(ctx, cq.client.genqlient, state.Id.ValueString(), note)
 	if err != nil {
 		diag.AddError(
 			"Unable to pause Cluster Queue dispatch",
@@ -674,7 +963,12 @@ func (cq *clusterQueueResource) pauseDispatch(ctx context.Context, timeout time.
 		)
 	}
 
-	return err
+	return r, err
+}
+
+// isAlreadyPausedError reports whether the API rejected pausing dispatch on a queue because it already is
+func isAlreadyPausedError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "already paused")
 }
 
 func (cq *clusterQueueResource) resumeDispatch(ctx context.Context, timeout time.Duration, state clusterQueueResourceModel, diag *diag.Diagnostics) error {
@@ -689,3 +983,105 @@ func (cq *clusterQueueResource) resumeDispatch(ctx context.Context, timeout time
 
 	return err
 }
+
+// drain pauses dispatch on the queue and waits until the jobs already running on it have finished, then stops its
+// agents if configured to. Dispatch is resumed if the drain fails, leaving the queue as its state describes.
+func (cq *clusterQueueResource) drain(ctx context.Context, timeout time.Duration, state clusterQueueResourceModel, diag *diag.Diagnostics) error {
+	if state.DispatchPaused.ValueBool() {
+		return cq.drainPaused(ctx, timeout, state, diag)
+	}
+
+	note := defaultDrainNote
+	if !state.DrainOnDestroy.Note.IsNull() {
+		note = state.DrainOnDestroy.Note.ValueString()
+	}
+	if _, err := cq.pauseDispatch(ctx, timeout, state, &note, diag); err != nil {
+		return err
+	}
+
+	err := cq.drainPaused(ctx, timeout, state, diag)
+	if err != nil {
+		cq.resumeDispatch(ctx, timeout, state, diag)
+	}
+	return err
+}
+
+// drainPaused waits for the running jobs of a queue whose dispatch is paused, then stops its agents if configured to.
+// Scheduled jobs are not dispatched while the queue is paused, so they are not waited for, but are reported as they
+// would never run once the queue is deleted.
+func (cq *clusterQueueResource) drainPaused(ctx context.Context, timeout time.Duration, state clusterQueueResourceModel, diag *diag.Diagnostics) error {
+	log.Printf("Waiting for the running jobs on cluster queue %s to finish ...", state.Key.ValueString())
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		r, err := getClusterQueueRunningJobs(ctx, cq.client.genqlient, cq.client.organization, state.Id.ValueString())
+		if err != nil {
+			return retryContextError(err)
+		}
+		if count := r.Organization.Jobs.Count; count > 0 {
+			return retry.RetryableError(fmt.Errorf("cluster queue %s still has %d running jobs", state.Key.ValueString(), count))
+		}
+		return nil
+	})
+	if err != nil {
+		diag.AddError(
+			"Unable to drain Cluster Queue",
+			fmt.Sprintf("Unable to drain cluster queue: %s", describeError(err)),
+		)
+		return err
+	}
+
+	scheduled, err := getClusterQueueScheduledJobs(ctx, cq.client.genqlient, cq.client.organization, state.Id.ValueString())
+	if err != nil {
+		diag.AddError(
+			"Unable to drain Cluster Queue",
+			fmt.Sprintf("Unable to read the scheduled jobs of cluster queue: %s", describeError(err)),
+		)
+		return err
+	}
+	if count := scheduled.Organization.Jobs.Count; count > 0 {
+		detail := fmt.Sprintf("Cluster queue %s has %d scheduled jobs, which will never run once it is deleted.", state.Key.ValueString(), count)
+		if !state.DrainOnDestroy.IgnoreScheduledJobs.ValueBool() {
+			diag.AddError(
+				"Unable to drain Cluster Queue",
+				detail+" Cancel them, or set drain_on_destroy.ignore_scheduled_jobs to delete the queue anyway.",
+			)
+			return fmt.Errorf("cluster queue %s has %d scheduled jobs", state.Key.ValueString(), count)
+		}
+		diag.AddWarning("Cluster Queue has scheduled jobs", detail)
+	}
+
+	if !state.DrainOnDestroy.StopAgents.ValueBool() {
+		return nil
+	}
+
+	var cursor *string
+	for {
+		r, err := getClusterQueueAgents(ctx, cq.client.genqlient, cq.client.organization, state.Id.ValueString(), cursor)
+		if err != nil {
+			diag.AddError(
+				"Unable to drain Cluster Queue",
+				fmt.Sprintf("Unable to read the agents of cluster queue: %s", describeError(err)),
+			)
+			return err
+		}
+
+		for _, edge := range r.Organization.Agents.Edges {
+			// agents that are already stopping or disconnected cannot be stopped
+			if edge.Node.ConnectionState != "connected" {
+				continue
+			}
+			log.Printf("Stopping agent %s on cluster queue %s", edge.Node.Name, state.Key.ValueString())
+			if _, err := stopAgent(ctx, cq.client.genqlient, edge.Node.Id); err != nil && !isNotFoundError(err) {
+				diag.AddError(
+					"Unable to drain Cluster Queue",
+					fmt.Sprintf("Unable to stop agent %s: %s", edge.Node.Name, describeError(err)),
+				)
+				return err
+			}
+		}
+
+		if !r.Organization.Agents.PageInfo.HasNextPage {
+			return nil
+		}
+		cursor = &r.Organization.Agents.PageInfo.EndCursor
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -374,6 +377,19 @@ func TestAccBuildkiteClusterQueueResource(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the resource by the name of its cluster and its key
+					ResourceName:      "buildkite_cluster_queue.foobar",
+					ImportStateIdFunc: testAccImportStateID("%s/%s", "buildkite_cluster.cluster_test.name", "buildkite_cluster_queue.foobar.key"),
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:  "buildkite_cluster_queue.foobar",
+					ImportStateId: fmt.Sprintf("queue-%s", queueKey),
+					ImportState:   true,
+					ExpectError:   regexp.MustCompile("Expected import identifier with format: id,cluster_uuid or\\s+cluster_name/queue_key"),
+				},
 			},
 		})
 	})
@@ -481,6 +497,335 @@ func TestAccBuildkiteClusterQueueResource(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("pauses dispatch with a note", func(t *testing.T) {
+		clusterName := acctest.RandString(10)
+		queueKey := acctest.RandString(10)
+		var cq clusterQueueResourceModel
+		config := func(paused bool, note string) string {
+			noteAttribute := ""
+			if note != "" {
+				noteAttribute = fmt.Sprintf("dispatch_paused_note = %q", note)
+			}
+			return fmt.Sprintf(`
+				resource "buildkite_cluster" "cluster_test" {
+					name = "Test cluster %s"
+				}
+
+				resource "buildkite_cluster_queue" "foobar" {
+					cluster_id = buildkite_cluster.cluster_test.id
+					key = "queue-%s"
+					dispatch_paused = %t
+					%s
+				}
+			`, clusterName, queueKey, paused, noteAttribute)
+		}
+		checkRemoteNote := func(note string) resource.TestCheckFunc {
+			return func(s *terraform.State) error {
+				r, err := getClusterQueues(context.Background(), genqlientGraphql, getenv("BUILDKITE_ORGANIZATION_SLUG"), cq.ClusterUuid.ValueString(), nil)
+				if err != nil {
+					return err
+				}
+				for _, edge := range r.Organization.Cluster.Queues.Edges {
+					if edge.Node.Id != cq.Id.ValueString() {
+						continue
+					}
+					if edge.Node.DispatchPausedNote == nil || *edge.Node.DispatchPausedNote != note {
+						return fmt.Errorf("expected dispatch to be paused with note %q, got %v", note, edge.Node.DispatchPausedNote)
+					}
+					return nil
+				}
+				return fmt.Errorf("cluster queue %s not found", cq.Id.ValueString())
+			}
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckClusterQueueDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config:      config(false, "Maintenance"),
+					ExpectError: regexp.MustCompile("dispatch_paused_note can only be set when dispatch_paused is true"),
+				},
+				{
+					Config: config(true, "Maintenance"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						testAccCheckClusterQueueExists("buildkite_cluster_queue.foobar", &cq),
+						checkRemoteNote("Maintenance"),
+						resource.TestCheckResourceAttr("buildkite_cluster_queue.foobar", "dispatch_paused_note", "Maintenance"),
+						resource.TestCheckResourceAttrSet("buildkite_cluster_queue.foobar", "dispatch_paused_at"),
+						resource.TestCheckResourceAttrSet("buildkite_cluster_queue.foobar", "dispatch_paused_by"),
+					),
+				},
+				{
+					// the API rejects pausing a paused queue again, so the note cannot be changed while it stays paused
+					Config:      config(true, "Upgrading agents"),
+					ExpectError: regexp.MustCompile(`cannot be changed while dispatch\s+is paused`),
+				},
+				{
+					Config: config(false, ""),
+					Check:  resource.TestCheckResourceAttr("buildkite_cluster_queue.foobar", "dispatch_paused", "false"),
+				},
+				{
+					Config: config(true, "Upgrading agents"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkRemoteNote("Upgrading agents"),
+						resource.TestCheckResourceAttr("buildkite_cluster_queue.foobar", "dispatch_paused_note", "Upgrading agents"),
+					),
+				},
+				{
+					// a pause made in the UI with another note is drift
+					PreConfig: func() {
+						ctx := context.Background()
+						if _, err := resumeDispatchClusterQueue(ctx, genqlientGraphql, cq.Id.ValueString()); err != nil {
+							t.Fatal(err)
+						}
+						note := "Paused in the UI"
+						if _, err := pauseDispatchClusterQueue(ctx, genqlientGraphql, cq.Id.ValueString(), &note); err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config:             config(true, "Upgrading agents"),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	})
+
+	t.Run("drains a cluster queue before deleting it", func(t *testing.T) {
+		clusterName := acctest.RandString(10)
+		queueKey := acctest.RandString(10)
+		cluster := fmt.Sprintf(`
+			resource "buildkite_cluster" "cluster_test" {
+				name = "Test cluster %s"
+			}
+		`, clusterName)
+		var queueID string
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckClusterQueueDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: cluster + fmt.Sprintf(`
+						resource "buildkite_cluster_queue" "foobar" {
+							cluster_id = buildkite_cluster.cluster_test.id
+							key = "queue-%s"
+							drain_on_destroy = {
+								note = "Retiring this queue"
+								stop_agents = true
+							}
+						}
+					`, queueKey),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_cluster_queue.foobar", "drain_on_destroy.note", "Retiring this queue"),
+						resource.TestCheckResourceAttr("buildkite_cluster_queue.foobar", "drain_on_destroy.stop_agents", "true"),
+						func(s *terraform.State) error {
+							queueID = s.RootModule().Resources["buildkite_cluster_queue.foobar"].Primary.ID
+							return nil
+						},
+					),
+				},
+				{
+					// removing the queue drains it, which finishes straight away as there are no jobs
+					Config: cluster,
+					Check: func(s *terraform.State) error {
+						r, err := getNode(context.Background(), genqlientGraphql, queueID)
+						if err != nil {
+							return err
+						}
+						if _, ok := r.GetNode().(*getNodeNodeClusterQueue); ok {
+							return fmt.Errorf("cluster queue %s still exists", queueID)
+						}
+						return nil
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestClusterQueueDrain(t *testing.T) {
+	server, client := fakeTestClient(t)
+	cq := &clusterQueueResource{client: client}
+
+	ctx := context.Background()
+	orgID, err := client.GetOrganizationID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster, err := createCluster(ctx, client.genqlient, *orgID, "Drain", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newQueue := func(t *testing.T, key string) clusterQueueResourceModel {
+		t.Helper()
+
+		r, err := createClusterQueue(ctx, client.genqlient, *orgID, cluster.ClusterCreate.Cluster.Id, key, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clusterQueueResourceModel{
+			Id:             types.StringValue(r.ClusterQueueCreate.ClusterQueue.Id),
+			ClusterUuid:    types.StringValue(cluster.ClusterCreate.Cluster.Uuid),
+			Key:            types.StringValue(key),
+			DispatchPaused: types.BoolValue(false),
+			DrainOnDestroy: &drainOnDestroyModel{
+				Note:       types.StringValue("Retiring this queue"),
+				StopAgents: types.BoolValue(true),
+			},
+		}
+	}
+
+	t.Run("waits for running jobs and stops agents", func(t *testing.T) {
+		queue := newQueue(t, "running")
+		agentID, err := server.AddAgent(queue.Id.ValueString(), "agent-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		jobID, err := server.AddJob(queue.Id.ValueString(), "RUNNING")
+		if err != nil {
+			t.Fatal(err)
+		}
+		finished := make(chan struct{})
+		time.AfterFunc(time.Second, func() {
+			close(finished)
+			server.Update(jobID, map[string]any{"state": "FINISHED"})
+		})
+
+		var diags diag.Diagnostics
+		if err := cq.drain(ctx, 30*time.Second, queue, &diags); err != nil {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		select {
+		case <-finished:
+		default:
+			t.Error("expected the drain to wait for the running job to finish")
+		}
+
+		queues, err := getClusterQueues(ctx, client.genqlient, server.Organization, queue.ClusterUuid.ValueString(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, edge := range queues.Organization.Cluster.Queues.Edges {
+			if edge.Node.Id != queue.Id.ValueString() {
+				continue
+			}
+			if !edge.Node.DispatchPaused || edge.Node.DispatchPausedNote == nil || *edge.Node.DispatchPausedNote != "Retiring this queue" {
+				t.Errorf("expected dispatch to be paused with the note, got %t %v", edge.Node.DispatchPaused, edge.Node.DispatchPausedNote)
+			}
+		}
+
+		agents, err := getClusterQueueAgents(ctx, client.genqlient, server.Organization, queue.Id.ValueString(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, edge := range agents.Organization.Agents.Edges {
+			if edge.Node.Id == agentID && edge.Node.ConnectionState != "stopping" {
+				t.Errorf("expected agent to be stopping, got %s", edge.Node.ConnectionState)
+			}
+		}
+	})
+
+	dispatchPaused := func(t *testing.T, queue clusterQueueResourceModel) bool {
+		t.Helper()
+
+		queues, err := getClusterQueues(ctx, client.genqlient, server.Organization, queue.ClusterUuid.ValueString(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, edge := range queues.Organization.Cluster.Queues.Edges {
+			if edge.Node.Id == queue.Id.ValueString() {
+				return edge.Node.DispatchPaused
+			}
+		}
+		t.Fatalf("queue %s not found", queue.Key.ValueString())
+		return false
+	}
+
+	t.Run("waits for assigned jobs", func(t *testing.T) {
+		queue := newQueue(t, "assigned")
+		if _, err := server.AddJob(queue.Id.ValueString(), "ASSIGNED"); err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		if err := cq.drain(ctx, time.Second, queue, &diags); err == nil {
+			t.Fatal("expected the drain to wait for the assigned job")
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "1 running jobs") {
+			t.Errorf("expected an error about the assigned job, got %v", diags)
+		}
+	})
+
+	t.Run("fails on scheduled jobs unless they are ignored", func(t *testing.T) {
+		queue := newQueue(t, "scheduled")
+		if _, err := server.AddJob(queue.Id.ValueString(), "SCHEDULED"); err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		if err := cq.drain(ctx, 5*time.Second, queue, &diags); err == nil {
+			t.Fatal("expected the drain to fail on the scheduled job")
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "1 scheduled jobs") {
+			t.Errorf("expected an error about the scheduled job, got %v", diags)
+		}
+		if dispatchPaused(t, queue) {
+			t.Error("expected dispatch to be resumed")
+		}
+
+		queue.DrainOnDestroy.IgnoreScheduledJobs = types.BoolValue(true)
+		diags = nil
+		if err := cq.drain(ctx, 5*time.Second, queue, &diags); err != nil {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "1 scheduled jobs") {
+			t.Errorf("expected a warning about the scheduled job, got %v", diags)
+		}
+	})
+
+	t.Run("resumes dispatch when jobs are still running after the timeout", func(t *testing.T) {
+		queue := newQueue(t, "timeout")
+		if _, err := server.AddJob(queue.Id.ValueString(), "RUNNING"); err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		if err := cq.drain(ctx, time.Second, queue, &diags); err == nil {
+			t.Fatal("expected the drain to time out")
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "1 running jobs") {
+			t.Errorf("expected an error about the running job, got %v", diags)
+		}
+		if dispatchPaused(t, queue) {
+			t.Error("expected dispatch to be resumed")
+		}
+	})
+
+	t.Run("leaves dispatch paused when it already was", func(t *testing.T) {
+		queue := newQueue(t, "paused")
+		note := "Paused by hand"
+		if _, err := pauseDispatchClusterQueue(ctx, client.genqlient, queue.Id.ValueString(), &note); err != nil {
+			t.Fatal(err)
+		}
+		queue.DispatchPaused = types.BoolValue(true)
+		if _, err := server.AddJob(queue.Id.ValueString(), "RUNNING"); err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		if err := cq.drain(ctx, time.Second, queue, &diags); err == nil {
+			t.Fatal("expected the drain to time out")
+		}
+		if !dispatchPaused(t, queue) {
+			t.Error("expected dispatch to still be paused")
+		}
+	})
 }
 
 func testAccCheckClusterQueueExists(resourceName string, clusterQueueResourceModel *clusterQueueResourceModel) resource.TestCheckFunc {
@@ -510,9 +855,6 @@ func testAccCheckClusterQueueExists(resourceName string, clusterQueueResourceMod
 				)
 				// If cluster queues were not able to be fetched by Genqlient
 				if err != nil {
-					if isRetryableError(err) {
-						return retry.RetryableError(err)
-					}
 					return retryContextError(err)
 				}
 
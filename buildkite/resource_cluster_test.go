@@ -156,6 +156,13 @@ func TestAccBuildkiteClusterResource(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the cluster by its name
+					ResourceName:      "buildkite_cluster.foo",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_cluster.foo.name"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -147,9 +147,12 @@ func (ob *organizationBannerResource) Read(ctx context.Context, req resource.Rea
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Organization banner", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read organization banner",
-			fmt.Sprintf("Unable to read organization banner: %s", err.Error()),
+			fmt.Sprintf("Unable to read organization banner: %s", describeError(err)),
 		)
 		return
 	}
@@ -235,12 +238,16 @@ func (ob *organizationBannerResource) Delete(ctx context.Context, req resource.D
 			)
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete organization banner",
-			fmt.Sprintf("Unable to delete organization banner %s", err.Error()),
+			fmt.Sprintf("Unable to delete organization banner %s", describeError(err)),
 		)
 		return
 	}
@@ -6,29 +6,46 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 type organizationRuleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	UUID        types.String `tfsdk:"uuid"`
-	Description types.String `tfsdk:"description"`
-	Type        types.String `tfsdk:"type"`
-	Value       types.String `tfsdk:"value"`
-	SourceType  types.String `tfsdk:"source_type"`
-	SourceUUID  types.String `tfsdk:"source_uuid"`
-	TargetType  types.String `tfsdk:"target_type"`
-	TargetUUID  types.String `tfsdk:"target_uuid"`
-	Effect      types.String `tfsdk:"effect"`
-	Action      types.String `tfsdk:"action"`
+	ID          types.String         `tfsdk:"id"`
+	UUID        types.String         `tfsdk:"uuid"`
+	Description types.String         `tfsdk:"description"`
+	Type        types.String         `tfsdk:"type"`
+	Value       jsontypes.Normalized `tfsdk:"value"`
+	SourceType  types.String         `tfsdk:"source_type"`
+	SourceUUID  types.String         `tfsdk:"source_uuid"`
+	TargetType  types.String         `tfsdk:"target_type"`
+	TargetUUID  types.String         `tfsdk:"target_uuid"`
+	Effect      types.String         `tfsdk:"effect"`
+	Action      types.String         `tfsdk:"action"`
+
+	PipelineTriggerBuild  *pipelineRuleModel `tfsdk:"pipeline_trigger_build"`
+	PipelineArtifactsRead *pipelineRuleModel `tfsdk:"pipeline_artifacts_read"`
+}
+
+// pipelineRuleModel is a typed alternative to the value document of rules between two pipelines
+type pipelineRuleModel struct {
+	SourcePipelineID types.String `tfsdk:"source_pipeline_id"`
+	TargetPipelineID types.String `tfsdk:"target_pipeline_id"`
+	Conditions       types.List   `tfsdk:"conditions"`
 }
 
 type ruleDocument struct {
@@ -42,10 +59,41 @@ type ruleValue struct {
 	Conditions []string `json:"conditions"`
 }
 
+// ruleValueField describes a key of an organization rule's value document
+type ruleValueField struct {
+	required bool
+	list     bool
+}
+
+// pipelineRuleValueFields are the keys of the value document of rules between two pipelines
+var pipelineRuleValueFields = map[string]ruleValueField{
+	"source_pipeline": {required: true},
+	"target_pipeline": {required: true},
+	"conditions":      {list: true},
+}
+
+// ruleValueFields maps each known rule type to the keys of its value document. Other types are left for the API to
+// validate.
+var ruleValueFields = map[string]map[string]ruleValueField{
+	"pipeline.trigger_build.pipeline":  pipelineRuleValueFields,
+	"pipeline.artifacts_read.pipeline": pipelineRuleValueFields,
+}
+
+// pipelineRuleTypes maps the typed rule attributes to the rule type they create
+var pipelineRuleTypes = map[string]string{
+	"pipeline_trigger_build":  "pipeline.trigger_build.pipeline",
+	"pipeline_artifacts_read": "pipeline.artifacts_read.pipeline",
+}
+
 type organizationRuleResource struct {
 	client *Client
 }
 
+var (
+	_ resource.ResourceWithValidateConfig = &organizationRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &organizationRuleResource{}
+)
+
 func newOrganizationRuleResource() resource.Resource {
 	return &organizationRuleResource{}
 }
@@ -89,15 +137,35 @@ func (organizationRuleResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "The description of the organization rule. ",
 			},
 			"type": resource_schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The type of organization rule. ",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The type of organization rule. Required when using `value`, and set from the typed rule attribute otherwise. ",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"value": resource_schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The JSON document that this organization rule implements. ",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "The JSON document that this organization rule implements. Generated by the provider when using a typed rule attribute. ",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("pipeline_trigger_build"),
+						path.MatchRoot("pipeline_artifacts_read"),
+					),
+				},
+			},
+			"pipeline_trigger_build": resource_schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "A `pipeline.trigger_build.pipeline` rule allowing the source pipeline to trigger builds of the target pipeline. Conflicts with `value`. ",
+				Attributes:          pipelineRuleAttributes("trigger builds of"),
+			},
+			"pipeline_artifacts_read": resource_schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "A `pipeline.artifacts_read.pipeline` rule allowing the source pipeline to read artifacts of the target pipeline. Conflicts with `value`. ",
+				Attributes:          pipelineRuleAttributes("read artifacts of"),
 			},
 			"source_type": resource_schema.StringAttribute{
 				Computed:            true,
@@ -139,6 +207,109 @@ func (organizationRuleResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func pipelineRuleAttributes(action string) map[string]resource_schema.Attribute {
+	return map[string]resource_schema.Attribute{
+		"source_pipeline_id": resource_schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("The GraphQL ID of the pipeline allowed to %s the target pipeline. ", action),
+		},
+		"target_pipeline_id": resource_schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The GraphQL ID of the target pipeline. ",
+		},
+		"conditions": resource_schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Conditions on the source build that must all be met for the rule to apply. ",
+		},
+	}
+}
+
+// ValidateConfig checks the value document against the keys of known rule types, so mistakes are reported when
+// planning rather than by the API
+func (or *organizationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType, value := config.Type, config.Value
+	if attribute, _, ok := config.pipelineRule(); ok {
+		if !ruleType.IsNull() && !ruleType.IsUnknown() && ruleType.ValueString() != pipelineRuleTypes[attribute] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Conflicting organization rule type",
+				fmt.Sprintf("The type must be %s when using %s, or can be left unset.", pipelineRuleTypes[attribute], attribute),
+			)
+		}
+		return
+	}
+
+	if ruleType.IsNull() && !value.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Missing organization rule type",
+			"The type must be set when using value.",
+		)
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	problems, warnings := validateRuleValue(ruleType, value.ValueString())
+	for _, problem := range problems {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid organization rule value", problem)
+	}
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(path.Root("value"), "Unknown organization rule value key", warning)
+	}
+}
+
+// ModifyPlan sets the type of typed rules, and keeps the generated value while the typed rule is unchanged
+func (or *organizationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan organizationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, rule, ok := plan.pipelineRule()
+	if !ok {
+		return
+	}
+
+	ruleType := pipelineRuleTypes[attribute]
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), ruleType)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state organizationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Type.ValueString() != ruleType {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+		return
+	}
+
+	if _, stateRule, ok := state.pipelineRule(); ok && rule.equal(stateRule) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), state.Value)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), jsontypes.NewNormalizedUnknown())...)
+	}
+}
+
 func (or *organizationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state organizationRuleResourceModel
 	var plannedValue ruleValue
@@ -157,8 +328,17 @@ func (or *organizationRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	value, err := or.ruleValue(ctx, timeout, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create organization rule",
+			fmt.Sprintf("Unable to create organization rule: %s", describeError(err)),
+		)
+		return
+	}
+
 	// Unmarshall the plan's value into a ruleValue struct instance
-	err := json.Unmarshal([]byte(plan.Value.ValueString()), &plannedValue)
+	err = json.Unmarshal([]byte(value), &plannedValue)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create organization rule",
@@ -194,7 +374,7 @@ func (or *organizationRuleResource) Create(ctx context.Context, req resource.Cre
 				*org,
 				plan.Description.ValueStringPointer(),
 				plan.Type.ValueString(),
-				plan.Value.ValueString(),
+				value,
 			)
 		}
 
@@ -219,7 +399,7 @@ func (or *organizationRuleResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Obtain the sorted value JSON from the API response (document field in RuleCreatePayload's rule)
-	value, err := obtainValueJSON(r.RuleCreate.Rule.Document)
+	createdValue, err := obtainValueJSON(r.RuleCreate.Rule.Document)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create organization rule",
@@ -229,7 +409,9 @@ func (or *organizationRuleResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Update organization rule model and set in state
-	updateOrganizatonRuleCreateState(&state, *r, *sourceUUID, *targetUUID, *value)
+	state.PipelineTriggerBuild = plan.PipelineTriggerBuild
+	state.PipelineArtifactsRead = plan.PipelineArtifactsRead
+	updateOrganizatonRuleCreateState(&state, *r, *sourceUUID, *targetUUID, *createdValue)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -259,9 +441,12 @@ func (or *organizationRuleResource) Read(ctx context.Context, req resource.ReadR
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Organization rule", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read organization rule",
-			fmt.Sprintf("Unable to read organmization rule: %s", err.Error()),
+			fmt.Sprintf("Unable to read organmization rule: %s", describeError(err)),
 		)
 		return
 	}
@@ -281,13 +466,16 @@ func (or *organizationRuleResource) Read(ctx context.Context, req resource.ReadR
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to read organization rule",
-				fmt.Sprintf("Unable to read organmization rule: %s", err.Error()),
+				fmt.Sprintf("Unable to read organmization rule: %s", describeError(err)),
 			)
 			return
 		}
 
 		// Update organization rule model and set in state
 		updateOrganizatonRuleReadState(&state, *organizationRule, *value)
+		if _, rule, ok := state.pipelineRule(); ok {
+			rule.refresh(organizationRule.OrganizationRuleFields, *value)
+		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
 		// Remove from state if not found{{}}
@@ -319,8 +507,17 @@ func (or *organizationRuleResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	value, err := or.ruleValue(ctx, timeout, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update organization rule",
+			fmt.Sprintf("Unable to update organization rule: %s", describeError(err)),
+		)
+		return
+	}
+
 	var r *updateOrganizationRuleResponse
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		org, err := or.client.GetOrganizationID()
 		if err == nil {
 			log.Printf("Updating organization rule with ID %s ...", state.ID.ValueString())
@@ -329,7 +526,7 @@ func (or *organizationRuleResource) Update(ctx context.Context, req resource.Upd
 				*org,
 				state.ID.ValueString(),
 				plan.Description.ValueStringPointer(),
-				*plan.Value.ValueStringPointer(),
+				value,
 			)
 		}
 
@@ -354,7 +551,7 @@ func (or *organizationRuleResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Obtain the sorted value JSON from the API response (document field in RuleCreatePayload's rule)
-	value, err := obtainValueJSON(r.RuleUpdate.Rule.Document)
+	updatedValue, err := obtainValueJSON(r.RuleUpdate.Rule.Document)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update organization rule",
@@ -363,7 +560,9 @@ func (or *organizationRuleResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	updateOrganizationRuleUpdateState(&state, *r, *sourceUUID, *targetUUID, *value)
+	state.PipelineTriggerBuild = plan.PipelineTriggerBuild
+	state.PipelineArtifactsRead = plan.PipelineArtifactsRead
+	updateOrganizationRuleUpdateState(&state, *r, *sourceUUID, *targetUUID, *updatedValue)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -395,12 +594,16 @@ func (or *organizationRuleResource) Delete(ctx context.Context, req resource.Del
 			)
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete organization rule",
-			fmt.Sprintf("Unable to delete organization rule: %s", err.Error()),
+			fmt.Sprintf("Unable to delete organization rule: %s", describeError(err)),
 		)
 		return
 	}
@@ -531,12 +734,179 @@ func obtainValueJSON(document string) (*string, error) {
 	return &value, nil
 }
 
+// pipelineRule returns the typed rule attribute that is set, if any
+func (or *organizationRuleResourceModel) pipelineRule() (string, *pipelineRuleModel, bool) {
+	switch {
+	case or.PipelineTriggerBuild != nil:
+		return "pipeline_trigger_build", or.PipelineTriggerBuild, true
+	case or.PipelineArtifactsRead != nil:
+		return "pipeline_artifacts_read", or.PipelineArtifactsRead, true
+	}
+	return "", nil, false
+}
+
+// ruleValue returns the value document to send to the API, generating it from the typed rule attribute if one is set
+func (or *organizationRuleResource) ruleValue(ctx context.Context, timeout time.Duration, plan organizationRuleResourceModel) (string, error) {
+	_, rule, ok := plan.pipelineRule()
+	if !ok {
+		return plan.Value.ValueString(), nil
+	}
+
+	sourceUUID, err := or.pipelineUUID(ctx, timeout, rule.SourcePipelineID.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("unable to find the source pipeline: %w", err)
+	}
+	targetUUID, err := or.pipelineUUID(ctx, timeout, rule.TargetPipelineID.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("unable to find the target pipeline: %w", err)
+	}
+
+	value := map[string]any{
+		"source_pipeline": sourceUUID,
+		"target_pipeline": targetUUID,
+	}
+	var conditions []string
+	if diags := rule.Conditions.ElementsAs(ctx, &conditions, false); diags.HasError() {
+		return "", errors.New("unable to read the rule's conditions")
+	}
+	if len(conditions) > 0 {
+		value["conditions"] = conditions
+	}
+
+	document, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(document), nil
+}
+
+// pipelineUUID looks up the UUID of a pipeline from its GraphQL ID, as rule documents refer to pipelines by UUID
+func (or *organizationRuleResource) pipelineUUID(ctx context.Context, timeout time.Duration, id string) (string, error) {
+	var r *getNodeResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		r, err = getNode(ctx, or.client.genqlient, id)
+		return retryContextError(err)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	pipeline, ok := r.GetNode().(*getNodeNodePipeline)
+	if !ok || pipeline == nil {
+		return "", fmt.Errorf("no pipeline found with ID %s", id)
+	}
+	return pipeline.PipelineUuid, nil
+}
+
+func (rule *pipelineRuleModel) equal(other *pipelineRuleModel) bool {
+	return rule.SourcePipelineID.Equal(other.SourcePipelineID) &&
+		rule.TargetPipelineID.Equal(other.TargetPipelineID) &&
+		rule.Conditions.Equal(other.Conditions)
+}
+
+// refresh updates a typed rule from the rule read from the API
+func (rule *pipelineRuleModel) refresh(fields OrganizationRuleFields, value string) {
+	if source, ok := fields.Source.(*OrganizationRuleFieldsSourcePipeline); ok {
+		rule.SourcePipelineID = types.StringValue(source.Id)
+	}
+	if target, ok := fields.Target.(*OrganizationRuleFieldsTargetPipeline); ok {
+		rule.TargetPipelineID = types.StringValue(target.Id)
+	}
+
+	var rv ruleValue
+	if err := json.Unmarshal([]byte(value), &rv); err != nil {
+		return
+	}
+	// keep conditions null rather than empty when there are none
+	if len(rv.Conditions) == 0 && len(rule.Conditions.Elements()) == 0 {
+		return
+	}
+	conditions := make([]attr.Value, len(rv.Conditions))
+	for i, condition := range rv.Conditions {
+		conditions[i] = types.StringValue(condition)
+	}
+	rule.Conditions = types.ListValueMust(types.StringType, conditions)
+}
+
+// validateRuleValue returns the problems with a rule's value document, along with warnings for keys that are not
+// known for the rule type. Unknown keys are not problems, as Buildkite may add keys to a rule type before the provider
+// knows about them. Only its syntax is checked when the rule type is unknown or not yet known.
+func validateRuleValue(ruleType types.String, value string) (problems, warnings []string) {
+	var document any
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		return []string{fmt.Sprintf("value is not valid JSON: %s", err.Error())}, nil
+	}
+
+	fields, ok := ruleValueFields[ruleType.ValueString()]
+	if ruleType.IsUnknown() || !ok {
+		return nil, nil
+	}
+
+	object, ok := document.(map[string]any)
+	if !ok {
+		return []string{fmt.Sprintf("%s: value must be a JSON object", ruleType.ValueString())}, nil
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := fields[name]
+		fieldValue, present := object[name]
+
+		switch {
+		case !present || fieldValue == nil || fieldValue == "":
+			if field.required {
+				problems = append(problems, fmt.Sprintf("%s: missing %s", ruleType.ValueString(), name))
+			}
+		case field.list:
+			if !isStringList(fieldValue) {
+				problems = append(problems, fmt.Sprintf("%s: %s must be a list of strings", ruleType.ValueString(), name))
+			}
+		default:
+			if _, ok := fieldValue.(string); !ok {
+				problems = append(problems, fmt.Sprintf("%s: %s must be a string", ruleType.ValueString(), name))
+			}
+		}
+	}
+
+	var unknown []string
+	for name := range object {
+		if _, ok := fields[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		warnings = append(warnings, fmt.Sprintf("%s: unknown key %s, expected one of %s", ruleType.ValueString(), name, strings.Join(names, ", ")))
+	}
+
+	return problems, warnings
+}
+
+func isStringList(value any) bool {
+	list, ok := value.([]any)
+	if !ok {
+		return false
+	}
+	for _, item := range list {
+		if _, ok := item.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func updateOrganizatonRuleCreateState(or *organizationRuleResourceModel, ruleCreate createOrganizationRuleResponse, sourceUUID, targetUUID, value string) {
 	or.ID = types.StringValue(ruleCreate.RuleCreate.Rule.Id)
 	or.UUID = types.StringValue(ruleCreate.RuleCreate.Rule.Uuid)
 	or.Description = types.StringPointerValue(ruleCreate.RuleCreate.Rule.Description)
 	or.Type = types.StringValue(ruleCreate.RuleCreate.Rule.Type)
-	or.Value = types.StringValue(value)
+	or.Value = jsontypes.NewNormalizedValue(value)
 	or.SourceType = types.StringValue(string(ruleCreate.RuleCreate.Rule.SourceType))
 	or.SourceUUID = types.StringValue(sourceUUID)
 	or.TargetType = types.StringValue(string(ruleCreate.RuleCreate.Rule.TargetType))
@@ -547,7 +917,7 @@ func updateOrganizatonRuleCreateState(or *organizationRuleResourceModel, ruleCre
 
 func updateOrganizationRuleUpdateState(or *organizationRuleResourceModel, ruleUpdate updateOrganizationRuleResponse, sourceUUID, targetUUID, value string) {
 	or.Description = types.StringPointerValue(ruleUpdate.RuleUpdate.Rule.Description)
-	or.Value = types.StringValue(value)
+	or.Value = jsontypes.NewNormalizedValue(value)
 	or.SourceUUID = types.StringValue(sourceUUID)
 	or.TargetUUID = types.StringValue(targetUUID)
 }
@@ -559,7 +929,7 @@ func updateOrganizatonRuleReadState(or *organizationRuleResourceModel, orn getNo
 	or.UUID = types.StringValue(orn.Uuid)
 	or.Description = types.StringPointerValue(orn.Description)
 	or.Type = types.StringValue(orn.Type)
-	or.Value = types.StringValue(value)
+	or.Value = jsontypes.NewNormalizedValue(value)
 	or.SourceType = types.StringValue(string(orn.SourceType))
 	or.SourceUUID = types.StringValue(sourceUUID)
 	or.TargetType = types.StringValue(string(orn.TargetType))
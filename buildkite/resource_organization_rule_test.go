@@ -7,8 +7,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -1824,6 +1826,179 @@ func TestAccBuildkiteOrganizationRuleResource(t *testing.T) {
 		})
 	})
 
+	t.Run("creates an organization rule with a formatted JSON value", func(t *testing.T) {
+		randNameOne := acctest.RandString(12)
+		randNameTwo := acctest.RandString(12)
+		config := fmt.Sprintf(`
+		resource "buildkite_pipeline" "pipeline_source" {
+			name       = "Pipeline %s"
+			repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+		}
+
+		resource "buildkite_pipeline" "pipeline_target" {
+			name       = "Pipeline %s"
+			repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+		}
+
+		resource "buildkite_organization_rule" "formatted_rule" {
+			type  = "pipeline.trigger_build.pipeline"
+			value = <<-EOT
+				{
+					"target_pipeline": "${buildkite_pipeline.pipeline_target.uuid}",
+					"source_pipeline": "${buildkite_pipeline.pipeline_source.uuid}"
+				}
+			EOT
+		}
+		`, randNameOne, randNameTwo)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckOrganizationRuleDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						// the configured formatting is kept rather than the API's sorted document
+						resource.TestMatchResourceAttr("buildkite_organization_rule.formatted_rule", "value", regexp.MustCompile(`^\{\n\t"target_pipeline"`)),
+						resource.TestCheckResourceAttrPair("buildkite_organization_rule.formatted_rule", "source_uuid", "buildkite_pipeline.pipeline_source", "uuid"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("plans an organization rule value with an unknown key", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "buildkite_organization_rule" "misspelt_rule" {
+						type = "pipeline.trigger_build.pipeline"
+						value = jsonencode({
+							source_pipeline = "0190f7b5-0d0a-4a71-9d6f-5b1a0e9b8c01"
+							target_pipeline = "0190f7b5-0d0a-4a71-9d6f-5b1a0e9b8c02"
+							condition = ["source.build.branch == 'main'"]
+						})
+					}
+					`,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	})
+
+	t.Run("creates and updates a typed organization rule", func(t *testing.T) {
+		randNameOne := acctest.RandString(12)
+		randNameTwo := acctest.RandString(12)
+		config := func(rule, conditions string) string {
+			return fmt.Sprintf(`
+			resource "buildkite_pipeline" "pipeline_source" {
+				name       = "Pipeline %s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+			}
+
+			resource "buildkite_pipeline" "pipeline_target" {
+				name       = "Pipeline %s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+			}
+
+			resource "buildkite_organization_rule" "typed_rule" {
+				%s = {
+					source_pipeline_id = buildkite_pipeline.pipeline_source.id
+					target_pipeline_id = buildkite_pipeline.pipeline_target.id
+					conditions         = %s
+				}
+			}
+			`, randNameOne, randNameTwo, rule, conditions)
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckOrganizationRuleDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config("pipeline_trigger_build", "null"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_organization_rule.typed_rule", "type", "pipeline.trigger_build.pipeline"),
+						resource.TestCheckResourceAttr("buildkite_organization_rule.typed_rule", "action", "TRIGGER_BUILD"),
+						resource.TestCheckResourceAttrPair("buildkite_organization_rule.typed_rule", "source_uuid", "buildkite_pipeline.pipeline_source", "uuid"),
+						resource.TestCheckResourceAttrPair("buildkite_organization_rule.typed_rule", "target_uuid", "buildkite_pipeline.pipeline_target", "uuid"),
+						resource.TestCheckNoResourceAttr("buildkite_organization_rule.typed_rule", "pipeline_trigger_build.conditions"),
+						resource.TestMatchResourceAttr("buildkite_organization_rule.typed_rule", "value", regexp.MustCompile(`"source_pipeline":"[0-9a-f-]{36}"`)),
+					),
+				},
+				{
+					Config: config("pipeline_trigger_build", `["source.build.branch == 'main'"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_organization_rule.typed_rule", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_organization_rule.typed_rule", "pipeline_trigger_build.conditions.0", "source.build.branch == 'main'"),
+						resource.TestMatchResourceAttr("buildkite_organization_rule.typed_rule", "value", regexp.MustCompile(`"conditions":\["source.build.branch == 'main'"\]`)),
+					),
+				},
+				{
+					Config: config("pipeline_artifacts_read", "null"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_organization_rule.typed_rule", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_organization_rule.typed_rule", "type", "pipeline.artifacts_read.pipeline"),
+						resource.TestCheckResourceAttr("buildkite_organization_rule.typed_rule", "action", "ARTIFACTS_READ"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("errors when a typed organization rule is combined with a value", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "buildkite_organization_rule" "conflicting_rule" {
+						type = "pipeline.trigger_build.pipeline"
+						value = jsonencode({
+							source_pipeline = "0190f7b5-0d0a-4a71-9d6f-5b1a0e9b8c01"
+							target_pipeline = "0190f7b5-0d0a-4a71-9d6f-5b1a0e9b8c02"
+						})
+						pipeline_trigger_build = {
+							source_pipeline_id = "UGlwZWxpbmUtLS0x"
+							target_pipeline_id = "UGlwZWxpbmUtLS0y"
+						}
+					}
+					`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				},
+				{
+					Config: `
+					resource "buildkite_organization_rule" "conflicting_rule" {
+						type = "pipeline.artifacts_read.pipeline"
+						pipeline_trigger_build = {
+							source_pipeline_id = "UGlwZWxpbmUtLS0x"
+							target_pipeline_id = "UGlwZWxpbmUtLS0y"
+						}
+					}
+					`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("The type must be pipeline.trigger_build.pipeline when using\\s+pipeline_trigger_build"),
+				},
+			},
+		})
+	})
+
 	t.Run("errors when an organization rule is created with an unknown action", func(t *testing.T) {
 		randNameOne := acctest.RandString(12)
 		randNameTwo := acctest.RandString(12)
@@ -2227,6 +2402,64 @@ func testAccCheckOrganizationRuleExists(orr *organizationRuleResourceModel, name
 	}
 }
 
+func TestValidateRuleValue(t *testing.T) {
+	testCases := map[string]struct {
+		ruleType types.String
+		value    string
+		expected []string
+		warnings []string
+	}{
+		"valid": {
+			ruleType: types.StringValue("pipeline.trigger_build.pipeline"),
+			value:    `{"source_pipeline":"a","target_pipeline":"b","conditions":["source.build.branch == 'main'"]}`,
+		},
+		"invalid JSON": {
+			ruleType: types.StringValue("pipeline.trigger_build.pipeline"),
+			value:    `{"source_pipeline":`,
+			expected: []string{"value is not valid JSON: unexpected end of JSON input"},
+		},
+		"not an object": {
+			ruleType: types.StringValue("pipeline.artifacts_read.pipeline"),
+			value:    `["a","b"]`,
+			expected: []string{"pipeline.artifacts_read.pipeline: value must be a JSON object"},
+		},
+		"missing and mistyped keys": {
+			ruleType: types.StringValue("pipeline.trigger_build.pipeline"),
+			value:    `{"source_pipeline":"","target_pipeline":1,"conditions":"source.build.branch == 'main'"}`,
+			expected: []string{
+				"pipeline.trigger_build.pipeline: conditions must be a list of strings",
+				"pipeline.trigger_build.pipeline: missing source_pipeline",
+				"pipeline.trigger_build.pipeline: target_pipeline must be a string",
+			},
+		},
+		"unknown keys": {
+			ruleType: types.StringValue("pipeline.trigger_build.pipeline"),
+			value:    `{"source_pipeline":"a","target_pipeline":"b","sourcepipeline":"c"}`,
+			warnings: []string{"pipeline.trigger_build.pipeline: unknown key sourcepipeline, expected one of conditions, source_pipeline, target_pipeline"},
+		},
+		"unknown rule type": {
+			ruleType: types.StringValue("pipeline.non_existent_action.pipeline"),
+			value:    `{"anything":true}`,
+		},
+		"rule type not yet known": {
+			ruleType: types.StringUnknown(),
+			value:    `{"anything":true}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			problems, warnings := validateRuleValue(testCase.ruleType, testCase.value)
+			if strings.Join(problems, "\n") != strings.Join(testCase.expected, "\n") {
+				t.Errorf("expected %q, got %q", testCase.expected, problems)
+			}
+			if strings.Join(warnings, "\n") != strings.Join(testCase.warnings, "\n") {
+				t.Errorf("expected warnings %q, got %q", testCase.warnings, warnings)
+			}
+		})
+	}
+}
+
 func testAccCheckOrganizationRuleRemoteValues(orr *organizationRuleResourceModel, sourceType, targetType, action, effect string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if orr.SourceType.ValueString() != sourceType {
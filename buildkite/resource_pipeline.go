@@ -9,8 +9,11 @@ import (
 	"unsafe"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/terraform-provider-buildkite/internal/customtypes"
 	custom_modifier "github.com/buildkite/terraform-provider-buildkite/internal/planmodifier"
+	"github.com/buildkite/terraform-provider-buildkite/internal/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -78,6 +81,8 @@ type pipelineResourceModel struct {
 	Color                                types.String           `tfsdk:"color"`
 	ClusterId                            types.String           `tfsdk:"cluster_id"`
 	DefaultTeamId                        types.String           `tfsdk:"default_team_id"`
+	DeletionPolicy                       types.String           `tfsdk:"deletion_policy"`
+	DeletionProtection                   types.Bool             `tfsdk:"deletion_protection"`
 	DefaultBranch                        types.String           `tfsdk:"default_branch"`
 	DefaultTimeoutInMinutes              types.Int64            `tfsdk:"default_timeout_in_minutes"`
 	Description                          types.String           `tfsdk:"description"`
@@ -91,12 +96,18 @@ type pipelineResourceModel struct {
 	SkipIntermediateBuilds               types.Bool             `tfsdk:"skip_intermediate_builds"`
 	SkipIntermediateBuildsBranchFilter   types.String           `tfsdk:"skip_intermediate_builds_branch_filter"`
 	Slug                                 types.String           `tfsdk:"slug"`
-	Steps                                types.String           `tfsdk:"steps"`
+	Steps                                customtypes.YAMLValue  `tfsdk:"steps"`
 	Tags                                 []types.String         `tfsdk:"tags"`
+	Teams                                []pipelineTeamsModel   `tfsdk:"teams"`
 	UUID                                 types.String           `tfsdk:"uuid"`
 	WebhookUrl                           types.String           `tfsdk:"webhook_url"`
 }
 
+type pipelineTeamsModel struct {
+	TeamId      types.String `tfsdk:"team_id"`
+	AccessLevel types.String `tfsdk:"access_level"`
+}
+
 type providerSettingsModel struct {
 	TriggerMode                             types.String `tfsdk:"trigger_mode"`
 	BuildPullRequests                       types.Bool   `tfsdk:"build_pull_requests"`
@@ -162,12 +173,16 @@ func (p *pipelineResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	p.client = req.ProviderData.(*Client)
+	p.client.warnMissingScopes("buildkite_pipeline", []string{"read_pipelines", "write_pipelines"}, &resp.Diagnostics)
 }
 
 func (p *pipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state pipelineResourceModel
+	var configSlug types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// the planned slug is known when derived from the name, so only a slug in the config is user-defined
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slug"), &configSlug)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -220,6 +235,17 @@ func (p *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 				}
 			}
 
+			// teams are authoritative when set, so the pipeline is created with exactly those teams
+			if plan.Teams != nil {
+				input.Teams = make([]PipelineTeamAssignmentInput, len(plan.Teams))
+				for i, team := range plan.Teams {
+					input.Teams[i] = PipelineTeamAssignmentInput{
+						Id:          team.TeamId.ValueString(),
+						AccessLevel: PipelineAccessLevels(team.AccessLevel.ValueString()),
+					}
+				}
+			}
+
 			response, err = createPipeline(ctx, p.client.genqlient, input)
 		}
 		return retryContextError(err)
@@ -236,15 +262,18 @@ func (p *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 	setPipelineModel(&state, &response.PipelineCreate.Pipeline)
 	state.WebhookUrl = types.StringValue(response.PipelineCreate.Pipeline.GetWebhookURL())
 	state.DefaultTeamId = plan.DefaultTeamId
+	state.DeletionPolicy = plan.DeletionPolicy
+	state.DeletionProtection = plan.DeletionProtection
+	state.Teams = plan.Teams
 
 	useSlugValue := response.PipelineCreate.Pipeline.Slug
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "slugSource", []byte(`{"source": "api"}`))...)
-	if len(plan.Slug.ValueString()) > 0 {
+	if len(configSlug.ValueString()) > 0 {
 		useSlugValue = plan.Slug.ValueString()
 
 		pipelineExtraInfo, err := updatePipelineSlug(ctx, response.PipelineCreate.Pipeline.Slug, useSlugValue, p.client, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to set pipeline slug from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to set pipeline slug from REST", p.client.describeScopedError(err, "write_pipelines"))
 			return
 		}
 
@@ -255,7 +284,7 @@ func (p *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 	if plan.ProviderSettings != nil {
 		pipelineExtraInfo, err := updatePipelineExtraInfo(ctx, useSlugValue, plan.ProviderSettings, p.client, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to set pipeline info from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to set pipeline info from REST", p.client.describeScopedError(err, "write_pipelines"))
 			return
 		}
 
@@ -264,7 +293,7 @@ func (p *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 		// no provider_settings provided, but we still need to read in the badge url
 		extraInfo, err := getPipelineExtraInfo(ctx, p.client, useSlugValue, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read pipeline info from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to read pipeline info from REST", p.client.describeScopedError(err, "read_pipelines"))
 			return
 		}
 		state.BadgeUrl = types.StringValue(extraInfo.BadgeUrl)
@@ -283,6 +312,10 @@ func (p *pipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !checkDeletion("pipeline", state.DeletionProtection, state.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
 	timeout, diags := p.client.timeouts.Delete(ctx, DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -299,7 +332,7 @@ func (p *pipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Could not archive pipeline",
-				fmt.Sprintf("Could not archive pipeline %s", err.Error()),
+				fmt.Sprintf("Could not archive pipeline %s", describeError(err)),
 			)
 		}
 		return
@@ -308,7 +341,7 @@ func (p *pipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		log.Printf("Deleting pipeline %s ...", state.Name.ValueString())
 		_, err := deletePipeline(ctx, p.client.genqlient, state.Id.ValueString())
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -317,7 +350,7 @@ func (p *pipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not delete pipeline",
-			fmt.Sprintf("Could not delete pipeline: %s", err.Error()),
+			fmt.Sprintf("Could not delete pipeline: %s", describeError(err)),
 		)
 	}
 }
@@ -347,9 +380,12 @@ func (p *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Pipeline", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read pipeline",
-			fmt.Sprintf("Unable to pipeline: %s", err.Error()),
+			fmt.Sprintf("Unable to pipeline: %s", describeError(err)),
 		)
 		return
 	}
@@ -363,7 +399,7 @@ func (p *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 		extraInfo, err := getPipelineExtraInfo(ctx, p.client, pipelineNode.Slug, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read pipeline info from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to read pipeline info from REST", p.client.describeScopedError(err, "read_pipelines"))
 			return
 		}
 
@@ -386,7 +422,30 @@ func (p *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 			resp.Diagnostics.AddError("Error encountered trying to read teams for pipeline", err.Error())
 		}
 
+		// teams are only read back when they are managed by this resource, otherwise they are left to any
+		// buildkite_pipeline_team resources
+		if state.Teams != nil {
+			err = retry.RetryContext(ctx, timeouts, func() *retry.RetryError {
+				teams, err := p.listPipelineTeams(ctx, pipelineNode.Slug)
+				if err == nil {
+					state.Teams = make([]pipelineTeamsModel, len(teams))
+					for i, team := range teams {
+						state.Teams[i] = pipelineTeamsModel{
+							TeamId:      types.StringValue(team.Team.Id),
+							AccessLevel: types.StringValue(string(team.AccessLevel)),
+						}
+					}
+				}
+				return retryContextError(err)
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to read teams for pipeline", describeError(err))
+				return
+			}
+		}
+
 		state.BadgeUrl = types.StringValue(extraInfo.BadgeUrl)
+		setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
@@ -484,9 +543,11 @@ func (*pipelineResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 			},
 			"default_team_id": schema.StringAttribute{
-				MarkdownDescription: "The GraphQL ID of the team to use as the default owner of the pipeline.",
+				MarkdownDescription: "The GraphQL ID of the team to use as the default owner of the pipeline. Conflicts with `teams`.",
 				Optional:            true,
 			},
+			"deletion_policy":     deletionPolicyAttribute("pipeline"),
+			"deletion_protection": deletionProtectionAttribute("pipeline"),
 			"default_branch": schema.StringAttribute{
 				Computed:            true,
 				Optional:            true,
@@ -571,11 +632,13 @@ func (*pipelineResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"steps": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
+				CustomType:          customtypes.YAMLType{},
 				MarkdownDescription: "The YAML steps to configure for the pipeline. Can also accept the `steps` attribute from the [`buildkite_signed_pipeline_steps`](/docs/data-sources/signed_pipeline_steps) data source to enable a signed pipeline. Defaults to `buildkite-agent pipeline upload`.",
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("pipeline_template_id"),
 					}...),
+					&resourcevalidator.PipelineStepsValidator{},
 				},
 			},
 			"tags": schema.SetAttribute{
@@ -585,6 +648,35 @@ func (*pipelineResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
 				MarkdownDescription: "Tags to attribute to the pipeline. Useful for searching by in the UI.",
 			},
+			"teams": schema.SetNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The teams that have access to the pipeline. When set, this is authoritative: teams that are not listed " +
+					"have their access removed. At least one team must be listed, as a pipeline cannot be left without teams. Do not use " +
+					"it together with `buildkite_pipeline_team` resources for the same pipeline. If not set, team access is not managed " +
+					"by this resource. Imported pipelines have their teams read into state, so configurations that do not set `teams` " +
+					"show a one-time update removing them from state, which does not change any team access.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("default_team_id")),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"team_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The GraphQL ID of the team.",
+						},
+						"access_level": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The access level for the team. Either `READ_ONLY`, `BUILD_AND_READ` or `MANAGE_BUILD_AND_READ`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(PipelineAccessLevelsReadOnly),
+									string(PipelineAccessLevelsBuildAndRead),
+									string(PipelineAccessLevelsManageBuildAndRead)),
+							},
+						},
+					},
+				},
+			},
 			"uuid": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The UUID of the pipeline.",
@@ -764,7 +856,10 @@ func (p *pipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
-	var configTemplate, configSteps types.String
+	p.checkDerivedSlug(ctx, req, resp)
+
+	var configTemplate types.String
+	var configSteps customtypes.YAMLValue
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pipeline_template_id"), &configTemplate)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("steps"), &configSteps)...)
 
@@ -798,11 +893,48 @@ func (p *pipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("steps"), types.StringNull())...)
 }
 
+// checkDerivedSlug leaves the planned slug unknown when the one derived from the name is already used by another
+// pipeline, as the API will not be able to use it, or when that cannot be checked
+func (p *pipelineResource) checkDerivedSlug(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if p.client == nil {
+		return
+	}
+
+	var configSlug, planSlug, stateSlug, id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slug"), &configSlug)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("slug"), &planSlug)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("slug"), &stateSlug)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	}
+
+	// only a slug derived by the provider needs checking
+	if resp.Diagnostics.HasError() || !configSlug.IsNull() || planSlug.IsNull() || planSlug.IsUnknown() || planSlug.Equal(stateSlug) {
+		return
+	}
+
+	lookupCtx, cancel := withPlanLookup(ctx)
+	defer cancel()
+	pipeline, err := findPipeline(lookupCtx, p.client, planSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("slug"),
+			"Unable to check pipeline slug",
+			fmt.Sprintf("Could not check whether the slug %q derived from the name is already used, so it will be known after apply: %s", planSlug.ValueString(), describeError(err)),
+		)
+	}
+	if err != nil || (pipeline != nil && pipeline.Id != id.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("slug"), types.StringUnknown())...)
+	}
+}
+
 func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state pipelineResourceModel
+	var configSlug types.String
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slug"), &configSlug)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -856,12 +988,12 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 
 	useSlugValue := response.PipelineUpdate.Pipeline.Slug
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "slugSource", []byte(`{"source": "api"}`))...)
-	if len(plan.Slug.ValueString()) > 0 {
+	if len(configSlug.ValueString()) > 0 {
 		useSlugValue = plan.Slug.ValueString()
 		if plan.Slug != state.Slug {
 			_, err := updatePipelineSlug(ctx, response.PipelineUpdate.Pipeline.Slug, useSlugValue, p.client, timeouts)
 			if err != nil {
-				resp.Diagnostics.AddError("Unable to set pipeline slug from REST", err.Error())
+				resp.Diagnostics.AddError("Unable to set pipeline slug from REST", p.client.describeScopedError(err, "write_pipelines"))
 				return
 			}
 
@@ -870,7 +1002,7 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to set pipeline slug from REST", err.Error())
+		resp.Diagnostics.AddError("Unable to set pipeline slug from REST", p.client.describeScopedError(err, "write_pipelines"))
 		return
 	}
 
@@ -878,7 +1010,7 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 
 	if plan.DefaultTeamId.IsNull() && !state.DefaultTeamId.IsNull() {
 		// if the plan is empty but was previously set, just remove the team
-		err = p.findAndRemoveTeam(ctx, state.DefaultTeamId.ValueString(), state.Slug.ValueString(), "")
+		err = p.findAndRemoveTeam(ctx, state.DefaultTeamId.ValueString(), state.Slug.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Could not remove default team", err.Error())
 			return
@@ -903,17 +1035,29 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 		state.DefaultTeamId = types.StringValue(r.TeamPipelineCreate.TeamPipelineEdge.Node.Team.Id)
 
 		// remove the old team
-		err = p.findAndRemoveTeam(ctx, previousTeamID, state.Slug.ValueString(), "")
+		err = p.findAndRemoveTeam(ctx, previousTeamID, state.Slug.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Could not remove previous default team", err.Error())
 			return
 		}
 	}
 
+	// when teams are no longer set, the existing access is left in place and no longer managed
+	if plan.Teams != nil {
+		err = p.reconcileTeams(ctx, timeouts, state.Id.ValueString(), useSlugValue, plan.Teams)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update teams for pipeline", describeError(err))
+			return
+		}
+	}
+	state.Teams = plan.Teams
+	state.DeletionPolicy = plan.DeletionPolicy
+	state.DeletionProtection = plan.DeletionProtection
+
 	if plan.ProviderSettings != nil {
 		pipelineExtraInfo, err := updatePipelineExtraInfo(ctx, useSlugValue, plan.ProviderSettings, p.client, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to set pipeline info from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to set pipeline info from REST", p.client.describeScopedError(err, "write_pipelines"))
 			return
 		}
 
@@ -929,7 +1073,7 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 
 		extraInfo, err := getPipelineExtraInfo(ctx, p.client, useSlugValue, timeouts)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read pipeline info from REST", err.Error())
+			resp.Diagnostics.AddError("Unable to read pipeline info from REST", p.client.describeScopedError(err, "read_pipelines"))
 			return
 		}
 		state.BadgeUrl = types.StringValue(extraInfo.BadgeUrl)
@@ -949,32 +1093,143 @@ func (p *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 // findAndRemoveTeam will try to find a team and remove its access from the pipeline
 // we only know the teams ID but the API request to remove access requies the pipeline team connection ID, so we need to
 // query all connected teams and check their ID matches
-func (p *pipelineResource) findAndRemoveTeam(ctx context.Context, teamID string, pipelineSlug string, cursor string) error {
+func (p *pipelineResource) findAndRemoveTeam(ctx context.Context, teamID string, pipelineSlug string) error {
+	teams, err := p.listPipelineTeams(ctx, pipelineSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		if team.Team.Id == teamID {
+			_, err := deleteTeamPipeline(ctx, p.client.genqlient, team.Id)
+			return err
+		}
+	}
+	return nil
+}
+
+// listPipelineTeams pages through every team with access to the pipeline
+func (p *pipelineResource) listPipelineTeams(ctx context.Context, pipelineSlug string) ([]PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline, error) {
 	slug := fmt.Sprintf("%s/%s", p.client.organization, pipelineSlug)
-	teams, err := getPipelineTeams(ctx, p.client.genqlient, slug, cursor)
+
+	var teams []PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline
+	cursor := ""
+	for {
+		r, err := getPipelineTeams(ctx, p.client.genqlient, slug, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range r.Pipeline.Teams.Edges {
+			teams = append(teams, edge.Node)
+		}
+
+		if !r.Pipeline.Teams.PageInfo.HasNextPage {
+			return teams, nil
+		}
+		cursor = r.Pipeline.Teams.PageInfo.EndCursor
+	}
+}
+
+// reconcileTeams makes the teams with access to the pipeline match the planned teams, adding missing teams, updating
+// access levels and removing any other teams
+func (p *pipelineResource) reconcileTeams(ctx context.Context, timeout time.Duration, pipelineID, pipelineSlug string, planned []pipelineTeamsModel) error {
+	var current []PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		current, err = p.listPipelineTeams(ctx, pipelineSlug)
+		return retryContextError(err)
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, team := range teams.Pipeline.Teams.Edges {
-		if team.Node.Team.Id == teamID {
-			_, err := deleteTeamPipeline(ctx, p.client.genqlient, team.Node.Id)
-			if err != nil {
-				return err
+	existing := make(map[string]PipelineTeamEdgesTeamPipelineEdgeNodeTeamPipeline, len(current))
+	for _, team := range current {
+		existing[team.Team.Id] = team
+	}
+
+	for _, team := range planned {
+		teamID := team.TeamId.ValueString()
+		accessLevel := PipelineAccessLevels(team.AccessLevel.ValueString())
+		found, ok := existing[teamID]
+		delete(existing, teamID)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			var err error
+			if !ok {
+				log.Printf("Adding team %s to pipeline %s ...", teamID, pipelineSlug)
+				_, err = createTeamPipeline(ctx, p.client.genqlient, teamID, pipelineID, accessLevel)
+			} else if found.AccessLevel != accessLevel {
+				log.Printf("Updating access level of team %s on pipeline %s ...", teamID, pipelineSlug)
+				_, err = updateTeamPipeline(ctx, p.client.genqlient, found.Id, accessLevel)
 			}
-			break
+			return retryContextError(err)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
-	// if there are more teams, recurse again with the next page
-	if teams.Pipeline.Teams.PageInfo.HasNextPage {
-		return p.findAndRemoveTeam(ctx, teamID, pipelineSlug, teams.Pipeline.Teams.PageInfo.EndCursor)
+	// anything left over was not planned, so has its access removed
+	for teamID, team := range existing {
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			log.Printf("Removing team %s from pipeline %s ...", teamID, pipelineSlug)
+			_, err := deleteTeamPipeline(ctx, p.client.genqlient, team.Id)
+			if err != nil && isNotFoundError(err) {
+				return nil
+			}
+			return retryContextError(err)
+		})
+		if err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
-func (*pipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (p *pipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// teams are only read when they are in state, so they start empty for Read to fill in
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("teams"), []pipelineTeamsModel{})...)
+
+	if isGraphQLID(req.ID, "Pipeline") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// otherwise the import identifier is the slug of the pipeline
+	pipeline, err := findPipeline(ctx, p.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import pipeline",
+			fmt.Sprintf("Unable to read pipeline %s: %s", req.ID, describeError(err)),
+		)
+		return
+	}
+	if pipeline == nil {
+		resp.Diagnostics.AddError("Unable to import pipeline", fmt.Sprintf("Could not find pipeline with slug %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), pipeline.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), pipeline.Slug)...)
+}
+
+// findPipeline returns the pipeline with the given slug, or nil when there is none
+func findPipeline(ctx context.Context, client *Client, slug string) (*getPipelinePipeline, error) {
+	log.Printf("Obtaining pipeline with slug %s ...", slug)
+	r, err := getPipeline(ctx, client.genqlient, fmt.Sprintf("%s/%s", client.organization, slug))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if r.Pipeline.Id == "" {
+		return nil, nil
+	}
+	return &r.Pipeline, nil
 }
 
 func setPipelineModel(model *pipelineResourceModel, data pipelineResponse) {
@@ -1003,9 +1258,9 @@ func setPipelineModel(model *pipelineResourceModel, data pipelineResponse) {
 	// complications later
 	if data.GetPipelineTemplate().Id != nil {
 		model.PipelineTemplateId = types.StringPointerValue(data.GetPipelineTemplate().Id)
-		model.Steps = types.StringNull()
+		model.Steps = customtypes.NewYAMLNull()
 	} else {
-		model.Steps = types.StringValue(data.GetSteps().Yaml)
+		model.Steps = customtypes.NewYAMLValue(data.GetSteps().Yaml)
 		model.PipelineTemplateId = types.StringNull()
 	}
 
@@ -1217,7 +1472,7 @@ func upgradePipelineStateV0toV1(ctx context.Context, req resource.UpgradeStateRe
 		SkipIntermediateBuilds:               priorPipelineStateData.SkipIntermediateBuilds,
 		SkipIntermediateBuildsBranchFilter:   priorPipelineStateData.SkipIntermediateBuildsBranchFilter,
 		Slug:                                 priorPipelineStateData.Slug,
-		Steps:                                priorPipelineStateData.Steps,
+		Steps:                                customtypes.YAMLValue{StringValue: priorPipelineStateData.Steps},
 		Tags:                                 priorPipelineStateData.Tags,
 		WebhookUrl:                           priorPipelineStateData.WebhookUrl,
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -199,9 +200,12 @@ func (ps *pipelineSchedule) Read(ctx context.Context, req resource.ReadRequest,
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Pipeline schedule", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read Pipeline schedule",
-			fmt.Sprintf("Unable to read Pipeline schedule: %s", err.Error()),
+			fmt.Sprintf("Unable to read Pipeline schedule: %s", describeError(err)),
 		)
 		return
 	}
@@ -296,7 +300,7 @@ func (ps *pipelineSchedule) Delete(ctx context.Context, req resource.DeleteReque
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		_, err := deletePipelineSchedule(ctx, ps.client.genqlient, plan.Id.ValueString())
 
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -305,14 +309,57 @@ func (ps *pipelineSchedule) Delete(ctx context.Context, req resource.DeleteReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Pipeline schedule",
-			fmt.Sprintf("Unable to delete Pipeline schedule: %s", err.Error()),
+			fmt.Sprintf("Unable to delete Pipeline schedule: %s", describeError(err)),
 		)
 		return
 	}
 }
 
 func (ps *pipelineSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "PipelineSchedule") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	pipelineSlug, label, ok := splitImportID(req.ID)
+	if !ok {
+		addImportIDError(&resp.Diagnostics, req.ID, "id", "pipeline_slug/label")
+		return
+	}
+
+	log.Printf("Getting schedules for pipeline %s ...", pipelineSlug)
+	r, err := getPipelineSchedules(ctx, ps.client.genqlient, fmt.Sprintf("%s/%s", ps.client.organization, pipelineSlug))
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to import Pipeline schedule",
+			fmt.Sprintf("Unable to read schedules of pipeline %s: %s", pipelineSlug, describeError(err)),
+		)
+		return
+	}
+
+	var ids []string
+	if r != nil {
+		for _, edge := range r.Pipeline.Schedules.Edges {
+			if edge.Node.Label != nil && *edge.Node.Label == label {
+				ids = append(ids, edge.Node.Id)
+			}
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Unable to import Pipeline schedule",
+			fmt.Sprintf("Could not find schedule with label %q on pipeline %q", label, pipelineSlug),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Unable to import Pipeline schedule",
+			fmt.Sprintf("Pipeline %q has %d schedules with label %q, import it by its GraphQL ID instead", pipelineSlug, len(ids), label),
+		)
+	}
 }
 
 func envVarsArrayToMap(ctx context.Context, envVars []*string) types.Map {
@@ -117,6 +117,13 @@ func TestAccBuildkitePipelineSchedule(t *testing.T) {
 					ImportStateId:     schedule.Id,
 					ImportStateVerify: true,
 				},
+				{
+					// import the schedule by the slug of its pipeline and its label
+					ResourceName:      "buildkite_pipeline_schedule.pipeline",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s/%s", "buildkite_pipeline.pipeline.slug", "buildkite_pipeline_schedule.pipeline.label"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
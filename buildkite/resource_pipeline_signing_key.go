@@ -0,0 +1,189 @@
+package buildkite
+
+import (
+	"context"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/terraform-provider-buildkite/internal/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+type pipelineSigningKeyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Algorithm   types.String `tfsdk:"algorithm"`
+	KeyID       types.String `tfsdk:"key_id"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	PrivateJWKS types.String `tfsdk:"private_jwks"`
+	PublicJWKS  types.String `tfsdk:"public_jwks"`
+}
+
+type pipelineSigningKeyResource struct{}
+
+func newPipelineSigningKeyResource() resource.Resource {
+	return &pipelineSigningKeyResource{}
+}
+
+func (pipelineSigningKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_signing_key"
+}
+
+func (pipelineSigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_schema.Schema{
+		MarkdownDescription: heredoc.Docf(
+			`
+				This resource generates a key pair for signing pipelines locally, without calling the Buildkite API.
+				The private key set can be used with the %s data source or the %s function, and
+				the public key set should be made available to the agents that verify the steps.
+
+				Changing any of the %s replaces the key pair with a new one. To rotate keys without
+				failing builds, set %s and publish the public keys of both the old and
+				new key pairs to the agents until every pipeline has been signed with the new key.
+
+				~> **Security Notice** The private key will be stored *unencrypted* in your Terraform
+				state file. Only use this resource if your state is stored securely.
+
+				See the Buildkite [documentation](https://buildkite.com/docs/agent/v3/signed_pipelines)
+				for more info about signed pipelines.
+			`,
+			"`buildkite_signed_pipeline_steps`",
+			"`provider::buildkite::sign_pipeline_steps`",
+			"`keepers`",
+			"`create_before_destroy`",
+		),
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the key, the same as `key_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"algorithm": resource_schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: heredoc.Docf(
+					`
+						The signature algorithm of the key. One of %s. EdDSA is recommended, as its
+						signatures are deterministic and won't change on each run.
+					`,
+					"`EdDSA`, `ES256`, `ES384`, `ES512`, `RS256` or `PS256`",
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(pipelineSigningAlgorithms...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": resource_schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the key in the key sets. Defaults to the RFC 7638 thumbprint of the key, which stays the same for the life of the key.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keepers": resource_schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that will generate a new key pair when they change, to rotate the key.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_jwks": resource_schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The JSON Web Key Set (JWKS) containing the private key, for signing steps.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_jwks": resource_schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The JSON Web Key Set (JWKS) containing the public key, for verifying signed steps.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (pipelineSigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineSigningKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := ""
+	if !plan.KeyID.IsUnknown() {
+		keyID = plan.KeyID.ValueString()
+	}
+
+	privateJWKS, publicJWKS, err := generateSigningKeyPair(jwa.SignatureAlgorithm(plan.Algorithm.ValueString()), keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate pipeline signing key", err.Error())
+		return
+	}
+
+	// check the generated key sets are accepted wherever a JWKS can be configured
+	for attribute, jwks := range map[string][]byte{"private_jwks": privateJWKS, "public_jwks": publicJWKS} {
+		validation := &validator.StringResponse{}
+		(&datasourcevalidator.JWKSValidator{}).ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root(attribute),
+			ConfigValue: types.StringValue(string(jwks)),
+		}, validation)
+		resp.Diagnostics.Append(validation.Diagnostics...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, diags := selectJWKSKey(publicJWKS, types.StringNull())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(key.KeyID())
+	plan.KeyID = types.StringValue(key.KeyID())
+	plan.PrivateJWKS = types.StringValue(string(privateJWKS))
+	plan.PublicJWKS = types.StringValue(string(publicJWKS))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read leaves the state as it is, as the key only exists in the state
+func (pipelineSigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only stores the new plan, as every attribute that changes the key pair forces it to be replaced
+func (pipelineSigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pipelineSigningKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the key from the state, which is done by the framework
+func (pipelineSigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
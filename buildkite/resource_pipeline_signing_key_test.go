@@ -0,0 +1,98 @@
+package buildkite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBuildkitePipelineSigningKey(t *testing.T) {
+	config := func(keyIDAndKeepers string) string {
+		return heredoc.Docf(
+			`
+				resource "buildkite_pipeline_signing_key" "key" {
+				  algorithm = "EdDSA"
+				  %s
+				}
+
+				data "buildkite_signed_pipeline_steps" "signed" {
+				  repository     = "my-repo"
+				  jwks           = buildkite_pipeline_signing_key.key.private_jwks
+				  unsigned_steps = "steps:\n- command: make test\n"
+				}
+
+				data "buildkite_verified_pipeline_steps" "verified" {
+				  repository = "my-repo"
+				  jwks       = buildkite_pipeline_signing_key.key.public_jwks
+				  steps      = data.buildkite_signed_pipeline_steps.signed.steps
+				}
+			`,
+			keyIDAndKeepers,
+		)
+	}
+
+	var keyID string
+	storeKeyID := func(s *terraform.State) error {
+		keyID = s.RootModule().Resources["buildkite_pipeline_signing_key.key"].Primary.Attributes["key_id"]
+		return nil
+	}
+	checkKeyID := func(changed bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			current := s.RootModule().Resources["buildkite_pipeline_signing_key.key"].Primary.Attributes["key_id"]
+			if (current != keyID) != changed {
+				return fmt.Errorf("expected key ID change to be %v, was %q and is now %q", changed, keyID, current)
+			}
+			return nil
+		}
+	}
+
+	t.Run("pipeline signing key signs steps that verify with its public key", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(`keepers = { rotated = "2024-01" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("buildkite_pipeline_signing_key.key", "key_id"),
+						resource.TestCheckResourceAttrPair("buildkite_pipeline_signing_key.key", "id", "buildkite_pipeline_signing_key.key", "key_id"),
+						resource.TestCheckResourceAttrSet("buildkite_pipeline_signing_key.key", "private_jwks"),
+						resource.TestCheckResourceAttrSet("buildkite_pipeline_signing_key.key", "public_jwks"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "true"),
+						storeKeyID,
+					),
+				},
+				{
+					Config:   config(`keepers = { rotated = "2024-01" }`),
+					PlanOnly: true,
+				},
+				{
+					Config: config(`keepers = { rotated = "2024-06" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkKeyID(true),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "true"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("pipeline signing key uses the configured key ID", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(`key_id = "my-key-id"`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_pipeline_signing_key.key", "key_id", "my-key-id"),
+						resource.TestCheckResourceAttr("data.buildkite_verified_pipeline_steps.verified", "verified", "true"),
+					),
+				},
+			},
+		})
+	})
+}
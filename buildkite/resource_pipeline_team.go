@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -153,9 +154,12 @@ func (tp *pipelineTeamResource) Read(ctx context.Context, req resource.ReadReque
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Team pipeline", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read team pipeline",
-			fmt.Sprintf("Unable to read team pipeline: %s", err.Error()),
+			fmt.Sprintf("Unable to read team pipeline: %s", describeError(err)),
 		)
 		return
 	}
@@ -179,7 +183,49 @@ func (tp *pipelineTeamResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (tp *pipelineTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "TeamPipeline") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	pipelineSlug, teamSlug, ok := splitImportID(req.ID)
+	if !ok {
+		addImportIDError(&resp.Diagnostics, req.ID, "id", "pipeline_slug/team_slug")
+		return
+	}
+
+	cursor := ""
+	for {
+		log.Printf("Getting teams for pipeline %s ...", pipelineSlug)
+		r, err := getPipelineTeams(ctx, tp.client.genqlient, fmt.Sprintf("%s/%s", tp.client.organization, pipelineSlug), cursor)
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Unable to import team pipeline",
+				fmt.Sprintf("Unable to read teams of pipeline %s: %s", pipelineSlug, describeError(err)),
+			)
+			return
+		}
+		if r == nil {
+			break
+		}
+
+		for _, edge := range r.Pipeline.Teams.Edges {
+			if edge.Node.Team.Slug == teamSlug {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), edge.Node.Id)...)
+				return
+			}
+		}
+
+		if !r.Pipeline.Teams.PageInfo.HasNextPage {
+			break
+		}
+		cursor = r.Pipeline.Teams.PageInfo.EndCursor
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to import team pipeline",
+		fmt.Sprintf("Could not find team %q with access to pipeline %q", teamSlug, pipelineSlug),
+	)
 }
 
 func (tp *pipelineTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -238,7 +284,7 @@ func (tp *pipelineTeamResource) Delete(ctx context.Context, req resource.DeleteR
 
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		_, err := deleteTeamPipeline(ctx, tp.client.genqlient, state.Id.ValueString())
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -247,7 +293,7 @@ func (tp *pipelineTeamResource) Delete(ctx context.Context, req resource.DeleteR
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete team pipeline",
-			fmt.Sprintf("Unable to delete team pipeline: %s", err.Error()),
+			fmt.Sprintf("Unable to delete team pipeline: %s", describeError(err)),
 		)
 		return
 	}
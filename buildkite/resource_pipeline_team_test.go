@@ -46,6 +46,13 @@ func TestAccBuildkitePipelineTeam(t *testing.T) {
 					ImportStateId:     tp.Id.ValueString(),
 					ImportStateVerify: true,
 				},
+				{
+					// import the pipeline team by the slugs of the pipeline and team
+					ResourceName:      "buildkite_pipeline_team.pipelineteam",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s/%s", "buildkite_pipeline.acc_test_pipeline.slug", "buildkite_team.acc_test_team.slug"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -6,23 +6,26 @@ import (
 	"log"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/buildkite/terraform-provider-buildkite/internal/customtypes"
+	"github.com/buildkite/terraform-provider-buildkite/internal/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 type pipelineTemplateResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	UUID          types.String `tfsdk:"uuid"`
-	Available     types.Bool   `tfsdk:"available"`
-	Configuration types.String `tfsdk:"configuration"`
-	Description   types.String `tfsdk:"description"`
-	Name          types.String `tfsdk:"name"`
+	ID            types.String          `tfsdk:"id"`
+	UUID          types.String          `tfsdk:"uuid"`
+	Available     types.Bool            `tfsdk:"available"`
+	Configuration customtypes.YAMLValue `tfsdk:"configuration"`
+	Description   types.String          `tfsdk:"description"`
+	Name          types.String          `tfsdk:"name"`
 }
 
 type pipelineTemplateResource struct {
@@ -74,7 +77,11 @@ func (pipelineTemplateResource) Schema(ctx context.Context, req resource.SchemaR
 			},
 			"configuration": resource_schema.StringAttribute{
 				Required:            true,
+				CustomType:          customtypes.YAMLType{},
 				MarkdownDescription: "The YAML step configuration for the pipeline template. ",
+				Validators: []validator.String{
+					&resourcevalidator.PipelineStepsValidator{},
+				},
 			},
 			"description": resource_schema.StringAttribute{
 				Optional:            true,
@@ -133,7 +140,7 @@ func (pt *pipelineTemplateResource) Create(ctx context.Context, req resource.Cre
 	state.ID = types.StringValue(r.PipelineTemplateCreate.PipelineTemplate.Id)
 	state.UUID = types.StringValue(r.PipelineTemplateCreate.PipelineTemplate.Uuid)
 	state.Name = types.StringValue(r.PipelineTemplateCreate.PipelineTemplate.Name)
-	state.Configuration = types.StringValue(r.PipelineTemplateCreate.PipelineTemplate.Configuration)
+	state.Configuration = customtypes.NewYAMLValue(r.PipelineTemplateCreate.PipelineTemplate.Configuration)
 	state.Description = types.StringPointerValue(r.PipelineTemplateCreate.PipelineTemplate.Description)
 	state.Available = types.BoolValue(r.PipelineTemplateCreate.PipelineTemplate.Available)
 
@@ -169,9 +176,12 @@ func (pt *pipelineTemplateResource) Read(ctx context.Context, req resource.ReadR
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Pipeline template", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read pipeline pipeline",
-			fmt.Sprintf("Unable to read pipeline template: %s", err.Error()),
+			fmt.Sprintf("Unable to read pipeline template: %s", describeError(err)),
 		)
 		return
 	}
@@ -195,7 +205,38 @@ func (pt *pipelineTemplateResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (pt *pipelineTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "PipelineTemplate") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// otherwise the import identifier is the name of the template
+	var cursor *string
+	for {
+		log.Printf("Searching for pipeline template with name %s ...", req.ID)
+		r, err := getPipelineTemplates(ctx, pt.client.genqlient, pt.client.organization, cursor)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import pipeline template",
+				fmt.Sprintf("Unable to read pipeline templates: %s", describeError(err)),
+			)
+			return
+		}
+
+		for _, template := range r.Organization.PipelineTemplates.Edges {
+			if template.Node.Name == req.ID {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), template.Node.Id)...)
+				return
+			}
+		}
+
+		if !r.Organization.PipelineTemplates.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &r.Organization.PipelineTemplates.PageInfo.EndCursor
+	}
+
+	resp.Diagnostics.AddError("Unable to import pipeline template", fmt.Sprintf("Could not find a pipeline template with name %q", req.ID))
 }
 
 func (pt *pipelineTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -245,7 +286,7 @@ func (pt *pipelineTemplateResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	state.Name = types.StringValue(r.PipelineTemplateUpdate.PipelineTemplate.Name)
-	state.Configuration = types.StringValue(r.PipelineTemplateUpdate.PipelineTemplate.Configuration)
+	state.Configuration = customtypes.NewYAMLValue(r.PipelineTemplateUpdate.PipelineTemplate.Configuration)
 	state.Description = types.StringPointerValue(r.PipelineTemplateUpdate.PipelineTemplate.Description)
 	state.Available = types.BoolValue(r.PipelineTemplateUpdate.PipelineTemplate.Available)
 
@@ -280,12 +321,16 @@ func (pt *pipelineTemplateResource) Delete(ctx context.Context, req resource.Del
 			)
 		}
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete pipeline template",
-			fmt.Sprintf("Unable to delete pipeline template: %s", err.Error()),
+			fmt.Sprintf("Unable to delete pipeline template: %s", describeError(err)),
 		)
 		return
 	}
@@ -295,7 +340,7 @@ func updatePipelineTemplateResourceState(ptr *pipelineTemplateResourceModel, ptn
 	ptr.ID = types.StringValue(ptn.Id)
 	ptr.UUID = types.StringValue(ptn.Uuid)
 	ptr.Available = types.BoolValue(ptn.Available)
-	ptr.Configuration = types.StringValue(ptn.Configuration)
+	ptr.Configuration = customtypes.NewYAMLValue(ptn.Configuration)
 	ptr.Description = types.StringPointerValue(ptn.Description)
 	ptr.Name = types.StringValue(ptn.Name)
 }
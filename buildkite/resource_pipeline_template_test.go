@@ -185,6 +185,13 @@ func TestAccBuildkitePipelineTemplateResource(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the template by its name
+					ResourceName:      "buildkite_pipeline_template.template_bar",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_pipeline_template.template_bar.name"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
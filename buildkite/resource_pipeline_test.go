@@ -4,13 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resource_framework "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccBuildkitePipelineResource(t *testing.T) {
@@ -115,6 +126,13 @@ func TestAccBuildkitePipelineResource(t *testing.T) {
 					ImportState:   true,
 					ImportStateId: pipeline.Id,
 				},
+				{
+					// import the pipeline by its slug
+					ResourceName:      "buildkite_pipeline.pipeline",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_pipeline.pipeline.slug"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -334,6 +352,109 @@ func TestAccBuildkitePipelineResource(t *testing.T) {
 		})
 	})
 
+	t.Run("plans the derived slug when creating and renaming a pipeline", func(t *testing.T) {
+		pipelineName := fmt.Sprintf("Derived Slug %s", acctest.RandString(12))
+		config := func(name string) string {
+			return fmt.Sprintf(`
+				resource "buildkite_pipeline" "pipeline" {
+					name = "%s"
+					repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+				}
+			`, name)
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(pipelineName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectKnownValue("buildkite_pipeline.pipeline", tfjsonpath.New("slug"), knownvalue.StringExact(slug.FromName(pipelineName))),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("buildkite_pipeline.pipeline", "slug", slug.FromName(pipelineName)),
+				},
+				{
+					Config: config(pipelineName + " Renamed"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_pipeline.pipeline", plancheck.ResourceActionUpdate),
+							plancheck.ExpectKnownValue("buildkite_pipeline.pipeline", tfjsonpath.New("slug"), knownvalue.StringExact(slug.FromName(pipelineName+" Renamed"))),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("buildkite_pipeline.pipeline", "slug", slug.FromName(pipelineName+" Renamed")),
+				},
+			},
+		})
+	})
+
+	t.Run("leaves the slug unknown when it cannot be predicted", func(t *testing.T) {
+		pipelineName := fmt.Sprintf("Unpredictable_%s", acctest.RandString(12))
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+						resource "buildkite_pipeline" "pipeline" {
+							name = "%s"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+						}
+					`, pipelineName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectUnknownValue("buildkite_pipeline.pipeline", tfjsonpath.New("slug")),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("buildkite_pipeline.pipeline", "slug", slug.FromName(pipelineName)),
+				},
+			},
+		})
+	})
+
+	t.Run("derives the same slugs as the API", func(t *testing.T) {
+		suffix := strings.ToLower(acctest.RandString(8))
+		// the slugs the API derives for these names, which FromName has to predict
+		names := map[string]string{
+			fmt.Sprintf("TesT --- PipeLine - %s", suffix):  fmt.Sprintf("test-pipeline-%s", suffix),
+			fmt.Sprintf("Deploy to Production %s", suffix): fmt.Sprintf("deploy-to-production-%s", suffix),
+			fmt.Sprintf("Build -- Test  %s", suffix):       fmt.Sprintf("build-test-%s", suffix),
+			fmt.Sprintf("already-a-slug-%s", suffix):       fmt.Sprintf("already-a-slug-%s", suffix),
+		}
+
+		var config strings.Builder
+		var planChecks []plancheck.PlanCheck
+		var checks []resource.TestCheckFunc
+		i := 0
+		for name, expected := range names {
+			address := fmt.Sprintf("buildkite_pipeline.pipeline%d", i)
+			fmt.Fprintf(&config, `
+				resource "buildkite_pipeline" "pipeline%d" {
+					name = "%s"
+					repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+				}
+			`, i, name)
+			planChecks = append(planChecks, plancheck.ExpectKnownValue(address, tfjsonpath.New("slug"), knownvalue.StringExact(expected)))
+			checks = append(checks, resource.TestCheckResourceAttr(address, "slug", expected))
+			i++
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:           config.String(),
+					ConfigPlanChecks: resource.ConfigPlanChecks{PreApply: planChecks},
+					Check:            resource.ComposeAggregateTestCheckFunc(checks...),
+				},
+			},
+		})
+	})
+
 	t.Run("remove user defined slug from existing pipeline", func(t *testing.T) {
 		var pipeline getPipelinePipeline
 		pipelineId := acctest.RandString(12)
@@ -699,6 +820,95 @@ func TestAccBuildkitePipelineResource(t *testing.T) {
 		})
 	})
 
+	t.Run("reformatted steps from the API do not change the pipeline", func(t *testing.T) {
+		pipelineName := acctest.RandString(12)
+		config := fmt.Sprintf(`
+			resource "buildkite_pipeline" "pipeline" {
+				name = "%s"
+				repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+				steps = "steps:\n  - label: test\n    command: make test\n"
+			}
+		`, pipelineName)
+
+		// save the same steps with different formatting and key order, as if the API had normalized them
+		reformatSteps := func(s *terraform.State) error {
+			slug := fmt.Sprintf("%s/%s", getenv("BUILDKITE_ORGANIZATION_SLUG"), s.RootModule().Resources["buildkite_pipeline.pipeline"].Primary.Attributes["slug"])
+			resp, err := getPipeline(context.Background(), genqlientGraphql, slug)
+			if err != nil {
+				return err
+			}
+
+			p := resp.Pipeline
+			tags := make([]PipelineTagInput, len(p.Tags))
+			for i, tag := range p.Tags {
+				tags[i] = PipelineTagInput{Label: tag.Label}
+			}
+			_, err = updatePipeline(context.Background(), genqlientGraphql, PipelineUpdateInput{
+				Id:                                   p.Id,
+				Name:                                 p.Name,
+				Description:                          p.Description,
+				Emoji:                                p.Emoji,
+				Color:                                p.Color,
+				Repository:                           PipelineRepositoryInput{Url: p.Repository.Url},
+				Steps:                                PipelineStepsInput{Yaml: "steps:\n- command: \"make test\"\n  label: test\n"},
+				DefaultBranch:                        p.DefaultBranch,
+				SkipIntermediateBuilds:               p.SkipIntermediateBuilds,
+				SkipIntermediateBuildsBranchFilter:   p.SkipIntermediateBuildsBranchFilter,
+				CancelIntermediateBuilds:             p.CancelIntermediateBuilds,
+				CancelIntermediateBuildsBranchFilter: p.CancelIntermediateBuildsBranchFilter,
+				AllowRebuilds:                        p.AllowRebuilds,
+				DefaultTimeoutInMinutes:              p.DefaultTimeoutInMinutes,
+				MaximumTimeoutInMinutes:              p.MaximumTimeoutInMinutes,
+				ClusterId:                            p.Cluster.Id,
+				Tags:                                 tags,
+				BranchConfiguration:                  p.BranchConfiguration,
+			})
+			return err
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check:  reformatSteps,
+				},
+				{
+					// the refreshed steps are semantically equal, so the configured formatting is kept in state
+					Config:   config,
+					PlanOnly: true,
+				},
+				{
+					Config: config,
+					Check:  resource.TestCheckResourceAttr("buildkite_pipeline.pipeline", "steps", "steps:\n  - label: test\n    command: make test\n"),
+				},
+			},
+		})
+	})
+
+	t.Run("pipeline with invalid steps YAML is rejected at plan time", func(t *testing.T) {
+		pipelineName := acctest.RandString(12)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+						resource "buildkite_pipeline" "pipeline" {
+							name = "%s"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							steps = "steps:\n  - command: [make test\n"
+						}
+					`, pipelineName),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`Invalid pipeline YAML`),
+				},
+			},
+		})
+	})
+
 	t.Run("pipeline can be deleted", func(t *testing.T) {
 		pipelineName := acctest.RandString(12)
 
@@ -1197,4 +1407,265 @@ func TestAccBuildkitePipelineResource(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("manages team access authoritatively", func(t *testing.T) {
+		randName := acctest.RandString(12)
+		config := func(teams string) string {
+			return fmt.Sprintf(`
+				resource "buildkite_team" "one" {
+					name = "%[1]s one"
+					privacy = "VISIBLE"
+					default_team = false
+					default_member_role = "MEMBER"
+				}
+				resource "buildkite_team" "two" {
+					name = "%[1]s two"
+					privacy = "VISIBLE"
+					default_team = false
+					default_member_role = "MEMBER"
+				}
+				resource "buildkite_pipeline" "pipeline" {
+					name = "%[1]s"
+					repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+					teams = %[2]s
+				}
+			`, randName, teams)
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(`[{ team_id = buildkite_team.one.id, access_level = "READ_ONLY" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_pipeline.pipeline", "teams.#", "1"),
+						testAccCheckPipelineTeamsRemote(map[string]string{"buildkite_team.one": "READ_ONLY"}),
+					),
+				},
+				{
+					Config: config(`[
+						{ team_id = buildkite_team.one.id, access_level = "MANAGE_BUILD_AND_READ" },
+						{ team_id = buildkite_team.two.id, access_level = "BUILD_AND_READ" },
+					]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_pipeline.pipeline", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: testAccCheckPipelineTeamsRemote(map[string]string{
+						"buildkite_team.one": "MANAGE_BUILD_AND_READ",
+						"buildkite_team.two": "BUILD_AND_READ",
+					}),
+				},
+				{
+					Config: config(`[{ team_id = buildkite_team.two.id, access_level = "BUILD_AND_READ" }]`),
+					Check:  testAccCheckPipelineTeamsRemote(map[string]string{"buildkite_team.two": "BUILD_AND_READ"}),
+				},
+				{
+					// imported pipelines have their teams read, so there is no diff for a configuration managing them
+					ResourceName:      "buildkite_pipeline.pipeline",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_pipeline.pipeline.slug"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("removes teams given access outside of terraform", func(t *testing.T) {
+		randName := acctest.RandString(12)
+		var teamID, pipelineID string
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+						resource "buildkite_team" "team" {
+							name = "%[1]s"
+							privacy = "VISIBLE"
+							default_team = false
+							default_member_role = "MEMBER"
+						}
+						resource "buildkite_team" "other" {
+							name = "%[1]s other"
+							privacy = "VISIBLE"
+							default_team = false
+							default_member_role = "MEMBER"
+						}
+						resource "buildkite_pipeline" "pipeline" {
+							name = "%[1]s"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							teams = [{ team_id = buildkite_team.team.id, access_level = "READ_ONLY" }]
+						}
+					`, randName),
+					Check: func(s *terraform.State) error {
+						teamID = s.RootModule().Resources["buildkite_team.other"].Primary.ID
+						pipelineID = s.RootModule().Resources["buildkite_pipeline.pipeline"].Primary.ID
+						return nil
+					},
+				},
+				{
+					PreConfig: func() {
+						_, err := createTeamPipeline(context.Background(), genqlientGraphql, teamID, pipelineID, PipelineAccessLevelsReadOnly)
+						if err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config: fmt.Sprintf(`
+						resource "buildkite_team" "team" {
+							name = "%[1]s"
+							privacy = "VISIBLE"
+							default_team = false
+							default_member_role = "MEMBER"
+						}
+						resource "buildkite_team" "other" {
+							name = "%[1]s other"
+							privacy = "VISIBLE"
+							default_team = false
+							default_member_role = "MEMBER"
+						}
+						resource "buildkite_pipeline" "pipeline" {
+							name = "%[1]s"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							teams = [{ team_id = buildkite_team.team.id, access_level = "READ_ONLY" }]
+						}
+					`, randName),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_pipeline.pipeline", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: testAccCheckPipelineTeamsRemote(map[string]string{"buildkite_team.team": "READ_ONLY"}),
+				},
+			},
+		})
+	})
+
+	t.Run("teams must not be empty", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "buildkite_pipeline" "pipeline" {
+							name = "no teams"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							teams = []
+						}
+					`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Attribute teams set must contain at least 1 elements"),
+				},
+			},
+		})
+	})
+
+	t.Run("teams conflicts with default_team_id", func(t *testing.T) {
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "buildkite_pipeline" "pipeline" {
+							name = "conflicting teams"
+							repository = "https://github.com/buildkite/terraform-provider-buildkite.git"
+							default_team_id = "VGVhbS0tLTE="
+							teams = [{ team_id = "VGVhbS0tLTE=", access_level = "READ_ONLY" }]
+						}
+					`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				},
+			},
+		})
+	})
+}
+
+// testAccCheckPipelineTeamsRemote checks the teams with access to the pipeline, keyed by the team resource name
+func testAccCheckPipelineTeamsRemote(expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources["buildkite_pipeline.pipeline"]
+		p := &pipelineResource{client: &Client{genqlient: genqlientGraphql, organization: getenv("BUILDKITE_ORGANIZATION_SLUG")}}
+		teams, err := p.listPipelineTeams(context.Background(), rs.Primary.Attributes["slug"])
+		if err != nil {
+			return err
+		}
+
+		actual := make(map[string]string, len(teams))
+		for _, team := range teams {
+			actual[team.Team.Id] = string(team.AccessLevel)
+		}
+		if len(actual) != len(expected) {
+			return fmt.Errorf("expected %d teams with access, got %d", len(expected), len(actual))
+		}
+		for name, accessLevel := range expected {
+			teamID := s.RootModule().Resources[name].Primary.ID
+			if actual[teamID] != accessLevel {
+				return fmt.Errorf("expected %s to have %s access, got %q", name, accessLevel, actual[teamID])
+			}
+		}
+		return nil
+	}
+}
+
+func TestPipelineDerivedSlugLookupFailure(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&clientConfig{
+		org:        "testing",
+		apiToken:   "token",
+		graphqlURL: server.URL,
+		restURL:    server.URL,
+		userAgent:  "testing",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &pipelineResource{client: client}
+
+	var schemaResp resource_framework.SchemaResponse
+	p.Schema(ctx, resource_framework.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attrType, nil)
+			if v, ok := values[name]; ok {
+				attributes[name] = v
+			}
+		}
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	name := tftypes.NewValue(tftypes.String, "Deploy")
+	req := resource_framework.ModifyPlanRequest{
+		Config: tfsdk.Config{Raw: object(map[string]tftypes.Value{"name": name}), Schema: schemaResp.Schema},
+		State:  tfsdk.State{Raw: tftypes.NewValue(objectType, nil), Schema: schemaResp.Schema},
+	}
+	resp := resource_framework.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Raw: object(map[string]tftypes.Value{
+			"name": name,
+			"slug": tftypes.NewValue(tftypes.String, slug.FromName("Deploy")),
+		}), Schema: schemaResp.Schema},
+	}
+	p.checkDerivedSlug(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() || len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Unable to check pipeline slug" {
+		t.Fatalf("expected a warning about the slug, got %v", resp.Diagnostics)
+	}
+
+	var planSlug types.String
+	resp.Plan.GetAttribute(ctx, path.Root("slug"), &planSlug)
+	if !planSlug.IsUnknown() {
+		t.Errorf("expected the slug to be unknown, got %s", planSlug)
+	}
 }
@@ -1,15 +1,13 @@
 package buildkite
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/MakeNowJust/heredoc"
 	bkplanmodifier "github.com/buildkite/terraform-provider-buildkite/internal/planmodifier"
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +33,9 @@ type registryResourceModel struct {
 	OIDCPolicy  types.String `tfsdk:"oidc_policy"`
 	Slug        types.String `tfsdk:"slug"`
 	TeamIDs     types.List   `tfsdk:"team_ids"`
+
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
 }
 
 func newRegistryResource() resource.Resource {
@@ -51,6 +52,7 @@ func (p *registryResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	p.client = req.ProviderData.(*Client)
+	p.client.warnMissingScopes("buildkite_registry", []string{"read_registries", "write_registries", "delete_registries"}, &resp.Diagnostics)
 }
 
 func (p *registryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -117,104 +119,128 @@ func (p *registryResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "The team IDs that have access to the registry.",
 				ElementType:         types.StringType,
 			},
+			"deletion_protection": deletionProtectionAttribute("registry"),
+			"deletion_policy":     deletionPolicyAttribute("registry"),
 		},
 	}
 }
 
-func (p *registryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state *registryResourceModel
+// registryResponse is the REST API representation of a registry
+type registryResponse struct {
+	GraphQLID   string   `json:"graphql_id"`
+	ID          string   `json:"id"`
+	Slug        string   `json:"slug"`
+	Name        string   `json:"name"`
+	Ecosystem   string   `json:"ecosystem"`
+	Description string   `json:"description"`
+	Emoji       string   `json:"emoji"`
+	Color       string   `json:"color"`
+	OIDCPolicy  string   `json:"oidc_policy"`
+	TeamIDs     []string `json:"team_ids"`
+}
 
-	diags := req.Plan.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	timeout, diags := p.client.timeouts.Create(ctx, DefaultTimeout)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (p *registryResource) registriesPath() string {
+	return fmt.Sprintf("/v2/packages/organizations/%s/registries", p.client.organization)
+}
 
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
+func (p *registryResource) registryPath(slug string) string {
+	return fmt.Sprintf("%s/%s", p.registriesPath(), slug)
+}
 
-		reqBody := map[string]interface{}{
-			"name":      state.Name.ValueString(),
-			"ecosystem": state.Ecosystem.ValueString(),
-		}
+// findRegistryByName lists the organization's registries to find one by name, for state that is missing the slug.
+// It returns nil if there is no registry with that name.
+func (p *registryResource) findRegistryByName(ctx context.Context, name string) (*registryResponse, error) {
+	registries, err := restList[registryResponse](ctx, p.client, p.registriesPath())
+	if err != nil {
+		return nil, err
+	}
 
-		// Add optional fields if they're set
-		if !state.Description.IsNull() && !state.Description.IsUnknown() {
-			reqBody["description"] = state.Description.ValueString()
-		}
-		if !state.Emoji.IsNull() && !state.Emoji.IsUnknown() {
-			reqBody["emoji"] = state.Emoji.ValueString()
-		}
-		if !state.Color.IsNull() && !state.Color.IsUnknown() {
-			reqBody["color"] = state.Color.ValueString()
-		}
-		if !state.OIDCPolicy.IsNull() && !state.OIDCPolicy.IsUnknown() {
-			reqBody["oidc_policy"] = state.OIDCPolicy.ValueString()
+	for _, registry := range registries {
+		if registry.Name == name {
+			return &registry, nil
 		}
+	}
+	return nil, nil
+}
 
-		// Get team IDs from the state
-		if !state.TeamIDs.IsNull() && !state.TeamIDs.IsUnknown() {
-			teamIDs := make([]string, 0)
-			teamIDsElements := state.TeamIDs.Elements()
+// registryRequestBody builds the create and update request body from a plan
+func registryRequestBody(model *registryResourceModel) map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"name":      model.Name.ValueString(),
+		"ecosystem": model.Ecosystem.ValueString(),
+	}
 
-			for _, element := range teamIDsElements {
-				if strVal, ok := element.(types.String); ok {
-					teamIDs = append(teamIDs, strVal.ValueString())
-				}
-			}
+	// Add optional fields if they're set
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		reqBody["description"] = model.Description.ValueString()
+	}
+	if !model.Emoji.IsNull() && !model.Emoji.IsUnknown() {
+		reqBody["emoji"] = model.Emoji.ValueString()
+	}
+	if !model.Color.IsNull() && !model.Color.IsUnknown() {
+		reqBody["color"] = model.Color.ValueString()
+	}
+	if !model.OIDCPolicy.IsNull() && !model.OIDCPolicy.IsUnknown() {
+		reqBody["oidc_policy"] = model.OIDCPolicy.ValueString()
+	}
 
-			if len(teamIDs) > 0 {
-				reqBody["team_ids"] = teamIDs
+	if !model.TeamIDs.IsNull() && !model.TeamIDs.IsUnknown() {
+		teamIDs := make([]string, 0)
+		for _, element := range model.TeamIDs.Elements() {
+			if strVal, ok := element.(types.String); ok {
+				teamIDs = append(teamIDs, strVal.ValueString())
 			}
 		}
 
-		// Marshal the request body to JSON
-		jsonBody, err := json.Marshal(reqBody)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error marshaling request body: %w", err))
-		}
-
-		// Create the HTTP request
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
+		if len(teamIDs) > 0 {
+			reqBody["team_ids"] = teamIDs
 		}
+	}
 
-		// Set request headers
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Accept", "application/json")
+	return reqBody
+}
 
-		// Execute the HTTP request
-		resp, err := p.client.http.Do(req)
-		if err != nil {
-			return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
+// setRegistryModel updates model from the API representation of a registry. Optional attributes the API returns
+// empty are set to null, unless they were explicitly configured as empty strings.
+func setRegistryModel(model *registryResourceModel, registry *registryResponse) {
+	optionalString := func(value string, existing types.String) types.String {
+		if value != "" {
+			return types.StringValue(value)
 		}
-		defer resp.Body.Close()
-
-		// Check for successful response
-		if resp.StatusCode >= 400 {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			return retry.RetryableError(fmt.Errorf("error creating registry (status %d): %s", resp.StatusCode, string(bodyBytes)))
+		if !existing.IsNull() && !existing.IsUnknown() && existing.ValueString() == "" {
+			return existing
 		}
+		return types.StringNull()
+	}
 
-		// Parse the response
-		var result struct {
-			GraphqlID   string   `json:"graphql_id"`
-			ID          string   `json:"id"`
-			Slug        string   `json:"slug"`
-			Name        string   `json:"name"`
-			Ecosystem   string   `json:"ecosystem"`
-			Description string   `json:"description"`
-			Emoji       string   `json:"emoji"`
-			Color       string   `json:"color"`
-			OIDCPolicy  string   `json:"oidc_policy"`
-			TeamIDs     []string `json:"team_ids"`
-		}
+	// Set the GraphQL ID as the Terraform ID and the API ID as the UUID
+	model.ID = types.StringValue(registry.GraphQLID)
+	model.UUID = types.StringValue(registry.ID)
+	model.Slug = types.StringValue(registry.Slug)
+	model.Name = types.StringValue(registry.Name)
+	model.Ecosystem = types.StringValue(registry.Ecosystem)
+	model.Description = optionalString(registry.Description, model.Description)
+	model.Emoji = optionalString(registry.Emoji, model.Emoji)
+	model.Color = optionalString(registry.Color, model.Color)
+	model.OIDCPolicy = optionalString(registry.OIDCPolicy, model.OIDCPolicy)
+	model.TeamIDs = handleTeamIDs(registry.TeamIDs, model.TeamIDs)
+}
 
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
+func (p *registryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *registryResourceModel
+
+	diags := req.Plan.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	timeout, diags := p.client.timeouts.Create(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		result, err := restSend[registryResponse](ctx, p.client, http.MethodPost, p.registriesPath(), registryRequestBody(state))
+		if err != nil {
+			return retryContextError(err)
 		}
 
 		// Ensure we have ID set in state - this is the UUID we need
@@ -222,46 +248,21 @@ func (p *registryResource) Create(ctx context.Context, req resource.CreateReques
 			return retry.NonRetryableError(fmt.Errorf("API response missing required ID field"))
 		}
 
-		// Set the GraphQL ID as the Terraform ID and the API ID as the UUID
-		state.ID = types.StringValue(result.GraphqlID)
-		state.UUID = types.StringValue(result.ID)
-		state.Slug = types.StringValue(result.Slug)
-		state.Name = types.StringValue(result.Name)
-		state.Ecosystem = types.StringValue(result.Ecosystem)
-
-		if result.Description != "" {
-			state.Description = types.StringValue(result.Description)
-		}
-		if result.Emoji != "" {
-			state.Emoji = types.StringValue(result.Emoji)
-		}
-		if result.Color != "" {
-			state.Color = types.StringValue(result.Color)
-		}
-		if result.OIDCPolicy != "" {
-			state.OIDCPolicy = types.StringValue(result.OIDCPolicy)
-		}
-
-		// Handle the team_ids response using the helper function
-		state.TeamIDs = handleTeamIDs(result.TeamIDs, state.TeamIDs)
-
+		setRegistryModel(state, result)
 		return nil
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating registry",
-			fmt.Sprintf("Could not create registry: %s", err),
+			fmt.Sprintf("Could not create registry: %s", p.client.describeScopedError(err, "write_registries")),
 		)
 		return
 	}
 
-	// Make sure to save state properly including the UUID
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Implementation for Read function with team_ids handling
 func (p *registryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var registryNotFound bool
 	var state *registryResourceModel
 
 	diags := req.State.Get(ctx, &state)
@@ -278,230 +279,19 @@ func (p *registryResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	var registry *registryResponse
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		// Check if we have a UUID to work with
+		var err error
 		if state.Slug.IsNull() || state.Slug.ValueString() == "" {
-			// If no slug is found, we need to fetch all registries and find by name
-			// This handles the case during import or when slug isn't in state
-			url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
-
-			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-			}
-
-			req.Header.Set("Accept", "application/json")
-
-			resp, err := p.client.http.Do(req)
-			if err != nil {
-				return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode >= 400 {
-				bodyBytes, _ := io.ReadAll(resp.Body)
-				return retry.RetryableError(fmt.Errorf("error listing registries (status %d): %s", resp.StatusCode, string(bodyBytes)))
-			}
-
-			// Read and parse all registries
-			bodyBytes, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error reading response body: %w", err))
-			}
-
-			var registries []struct {
-				GraphqlID   string   `json:"graphql_id"`
-				ID          string   `json:"id"`
-				Slug        string   `json:"slug"`
-				Name        string   `json:"name"`
-				Ecosystem   string   `json:"ecosystem"`
-				Description string   `json:"description,omitempty"`
-				Emoji       string   `json:"emoji,omitempty"`
-				Color       string   `json:"color,omitempty"`
-				OIDCPolicy  string   `json:"oidc_policy,omitempty"`
-				TeamIDs     []string `json:"team_ids,omitempty"`
-			}
-
-			if err := json.Unmarshal(bodyBytes, &registries); err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
-			}
-
-			// Find the registry by name
-			found := false
-			for _, registry := range registries {
-				if registry.Name == state.Name.ValueString() {
-					// Found a match, update state with complete information including UUID
-					state.ID = types.StringValue(registry.GraphqlID)
-					state.UUID = types.StringValue(registry.ID)
-					state.Slug = types.StringValue(registry.Slug)
-					state.Name = types.StringValue(registry.Name)
-					state.Ecosystem = types.StringValue(registry.Ecosystem)
-
-					if registry.Description != "" {
-						state.Description = types.StringValue(registry.Description)
-					} else {
-						state.Description = types.StringNull()
-					}
-
-					if registry.Emoji != "" {
-						state.Emoji = types.StringValue(registry.Emoji)
-					} else {
-						state.Emoji = types.StringNull()
-					}
-
-					if registry.Color != "" {
-						state.Color = types.StringValue(registry.Color)
-					} else {
-						state.Color = types.StringNull()
-					}
-
-					if registry.OIDCPolicy != "" {
-						state.OIDCPolicy = types.StringValue(registry.OIDCPolicy)
-					} else {
-						state.OIDCPolicy = types.StringNull()
-					}
-
-					// Handle the team_ids response
-					state.TeamIDs = handleTeamIDs(registry.TeamIDs, state.TeamIDs)
-
-					found = true
-					break
-				}
-			}
-
-			if !found {
-				registryNotFound = true
-				return nil
-			}
-
-			return nil
-		}
-
-		// We have a UUID, use it to directly fetch the registry
-		url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries/%s", p.client.restURL, p.client.organization, state.Slug.ValueString())
-
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-		}
-
-		req.Header.Set("Accept", "application/json")
-
-		resp, err := p.client.http.Do(req)
-		if err != nil {
-			return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode == http.StatusNotFound {
-			registryNotFound = true
-			return nil
-		}
-
-		if resp.StatusCode >= 400 {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			return retry.RetryableError(fmt.Errorf("error reading registry (status %d): %s", resp.StatusCode, string(bodyBytes)))
-		}
-
-		// Read the entire response body to check if it's an array or a single object
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error reading response body: %w", err))
-		}
-
-		// Define the struct for a single registry
-		var result struct {
-			GraphQLID   string   `json:"graphql_id"`
-			ID          string   `json:"id"`
-			Name        string   `json:"name"`
-			Slug        string   `json:"slug"`
-			Ecosystem   string   `json:"ecosystem"`
-			Description string   `json:"description,omitempty"`
-			Emoji       string   `json:"emoji,omitempty"`
-			Color       string   `json:"color,omitempty"`
-			OIDCPolicy  string   `json:"oidc_policy,omitempty"`
-			TeamIDs     []string `json:"team_ids,omitempty"`
-		}
-
-		// Try to detect if response is an array and handle appropriately
-		if len(bodyBytes) > 0 && bodyBytes[0] == '[' {
-			// It's an array, so we need to decode as array and find the right registry
-			var registries []struct {
-				GraphQLID   string   `json:"graphql_id"`
-				ID          string   `json:"id"`
-				Name        string   `json:"name"`
-				Slug        string   `json:"slug"`
-				Ecosystem   string   `json:"ecosystem"`
-				Description string   `json:"description,omitempty"`
-				Emoji       string   `json:"emoji,omitempty"`
-				Color       string   `json:"color,omitempty"`
-				OIDCPolicy  string   `json:"oidc_policy,omitempty"`
-				TeamIDs     []string `json:"team_ids,omitempty"`
-			}
-
-			if err := json.Unmarshal(bodyBytes, &registries); err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
-			}
-
-			// Find the registry with the matching UUID
-			found := false
-			for _, registry := range registries {
-				if registry.ID == state.UUID.ValueString() {
-					result = registry
-					found = true
-					break
-				}
-			}
-
-			if !found {
-				registryNotFound = true
-				return nil
-			}
+			// Without a slug, such as during import, the registry can only be found by name
+			registry, err = p.findRegistryByName(ctx, state.Name.ValueString())
 		} else {
-			// It's a single object (expected format), decode directly
-			if err := json.Unmarshal(bodyBytes, &result); err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
-			}
+			registry, err = restGet[registryResponse](ctx, p.client, p.registryPath(state.Slug.ValueString()))
 		}
-
-		// Update the state with the found registry data
-		state.ID = types.StringValue(result.GraphQLID)
-		state.UUID = types.StringValue(result.ID)
-		state.Slug = types.StringValue(result.Slug)
-		state.Name = types.StringValue(result.Name)
-		state.Ecosystem = types.StringValue(result.Ecosystem)
-
-		if result.Description != "" {
-			state.Description = types.StringValue(result.Description)
-		} else {
-			state.Description = types.StringNull()
-		}
-
-		if result.Emoji != "" {
-			state.Emoji = types.StringValue(result.Emoji)
-		} else {
-			state.Emoji = types.StringNull()
-		}
-
-		if result.Color != "" {
-			state.Color = types.StringValue(result.Color)
-		} else {
-			state.Color = types.StringNull()
-		}
-
-		if result.OIDCPolicy != "" {
-			state.OIDCPolicy = types.StringValue(result.OIDCPolicy)
-		} else {
-			state.OIDCPolicy = types.StringNull()
-		}
-
-		// Handle the team_ids response using the helper function
-		state.TeamIDs = handleTeamIDs(result.TeamIDs, state.TeamIDs)
-
-		return nil
+		return retryContextError(err)
 	})
 
-	if registryNotFound {
+	if registry == nil && (err == nil || isNotFoundError(err)) {
 		var idForWarning string
 		// Always prefer to show ID in messages for consistency with other resources
 		if state.Slug.IsNull() {
@@ -522,15 +312,17 @@ func (p *registryResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading registry",
-			fmt.Sprintf("Could not read registry: %s", err),
+			fmt.Sprintf("Could not read registry: %s", p.client.describeScopedError(err, "read_registries")),
 		)
 		return
 	}
 
+	setRegistryModel(state, registry)
+	setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Implementation for Update function with team_ids handling
 func (p *registryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state *registryResourceModel
 
@@ -560,251 +352,55 @@ func (p *registryResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// First, ensure we have UUID set in state
-	if state.UUID.IsNull() || state.UUID.ValueString() == "" {
-		// We need to find the registry by name to get its UUID first
-		// This helps ensure proper updates when the UUID wasn't saved in the previous run
+	// The update is made against the slug, so find the registry by name if it wasn't saved in the previous run
+	if state.Slug.IsNull() || state.Slug.ValueString() == "" {
 		readTimeout, _ := p.client.timeouts.Read(ctx, DefaultTimeout)
 
 		err := retry.RetryContext(ctx, readTimeout, func() *retry.RetryError {
-			url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
-
-			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-			}
-
-			req.Header.Set("Accept", "application/json")
-
-			resp, err := p.client.http.Do(req)
-			if err != nil {
-				return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode >= 400 {
-				bodyBytes, _ := io.ReadAll(resp.Body)
-				return retry.RetryableError(fmt.Errorf("error listing registries (status %d): %s", resp.StatusCode, string(bodyBytes)))
-			}
-
-			bodyBytes, err := io.ReadAll(resp.Body)
+			registry, err := p.findRegistryByName(ctx, state.Name.ValueString())
 			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error reading response body: %w", err))
-			}
-
-			var registries []struct {
-				GraphqlID string `json:"graphql_id"`
-				ID        string `json:"id"`
-				Slug      string `json:"slug"`
-				Name      string `json:"name"`
-				Ecosystem string `json:"ecosystem"`
-			}
-
-			if err := json.Unmarshal(bodyBytes, &registries); err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
+				return retryContextError(err)
 			}
-
-			// Find registry by name
-			for _, registry := range registries {
-				if registry.Name == state.Name.ValueString() {
-					// Update the state with the UUID
-					state.UUID = types.StringValue(registry.ID)
-					return nil
-				}
+			if registry == nil {
+				return retry.NonRetryableError(fmt.Errorf("registry %s not found", state.Name.ValueString()))
 			}
 
-			// If we didn't find the registry, it might not exist
-			return retry.NonRetryableError(fmt.Errorf("registry %s not found", state.Name.ValueString()))
+			state.UUID = types.StringValue(registry.ID)
+			state.Slug = types.StringValue(registry.Slug)
+			return nil
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error retrieving registry UUID",
-				fmt.Sprintf("Could not find registry UUID: %s", err),
+				fmt.Sprintf("Could not find registry UUID: %s", p.client.describeScopedError(err, "read_registries")),
 			)
 			return
 		}
 	}
 
-	// Now proceed with the update using the UUID we have
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		// First try to use UUID for lookup if it's available
-		var lookupID string
-
-		if !state.Slug.IsNull() && state.Slug.ValueString() != "" {
-			lookupID = state.Slug.ValueString()
-		} else {
-			return retry.NonRetryableError(fmt.Errorf("no valid ID or UUID available for registry lookup"))
-		}
-
-		url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries/%s", p.client.restURL, p.client.organization, lookupID)
-
-		reqBody := map[string]interface{}{
-			"name":      plan.Name.ValueString(),
-			"ecosystem": plan.Ecosystem.ValueString(),
-		}
-
-		if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
-			reqBody["description"] = plan.Description.ValueString()
-		}
-		if !plan.Emoji.IsNull() && !plan.Emoji.IsUnknown() {
-			reqBody["emoji"] = plan.Emoji.ValueString()
-		}
-		if !plan.Color.IsNull() && !plan.Color.IsUnknown() {
-			reqBody["color"] = plan.Color.ValueString()
-		}
-		if !plan.OIDCPolicy.IsNull() && !plan.OIDCPolicy.IsUnknown() {
-			reqBody["oidc_policy"] = plan.OIDCPolicy.ValueString()
-		}
-
-		if !plan.Ecosystem.IsNull() && !plan.Ecosystem.IsUnknown() {
-			reqBody["ecosystem"] = plan.Ecosystem.ValueString()
-		}
-
-		// Handle team_ids in the plan
-		if !plan.TeamIDs.IsNull() && !plan.TeamIDs.IsUnknown() {
-			teamIDs := make([]string, 0)
-			teamIDsElements := plan.TeamIDs.Elements()
-
-			for _, element := range teamIDsElements {
-				if strVal, ok := element.(types.String); ok {
-					teamIDs = append(teamIDs, strVal.ValueString())
-				}
-			}
-
-			if len(teamIDs) > 0 {
-				reqBody["team_ids"] = teamIDs
-			}
-		} else {
-			// If the plan has an empty list, we should pass an empty array to clear the team IDs
+		reqBody := registryRequestBody(plan)
+		if _, ok := reqBody["team_ids"]; !ok {
+			// Pass an empty array to clear the team IDs
 			reqBody["team_ids"] = []string{}
 		}
 
-		jsonBody, err := json.Marshal(reqBody)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error marshaling request body: %w", err))
-		}
-
-		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
+		result, err := restSend[registryResponse](ctx, p.client, http.MethodPut, p.registryPath(state.Slug.ValueString()), reqBody)
+		if isNotFoundError(err) {
+			// The registry no longer exists, so create it anew
+			result, err = restSend[registryResponse](ctx, p.client, http.MethodPost, p.registriesPath(), reqBody)
 		}
-
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Accept", "application/json")
-
-		resp, err := p.client.http.Do(req)
 		if err != nil {
-			return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode == http.StatusNotFound {
-			// If registry not found during update, check if it exists at all by reading
-			readURL := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
-			readReq, err := http.NewRequestWithContext(ctx, http.MethodGet, readURL, nil)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-			}
-
-			readReq.Header.Set("Accept", "application/json")
-			readResp, err := p.client.http.Do(readReq)
-			if err != nil {
-				return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-			}
-			defer readResp.Body.Close()
-
-			// If we can read the registries, try to recreate instead of update
-			if readResp.StatusCode < 400 {
-				// Try to create the registry anew since the UUID doesn't seem to exist
-				createURL := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
-				createReq, err := http.NewRequestWithContext(ctx, http.MethodPost, createURL, bytes.NewBuffer(jsonBody))
-				if err != nil {
-					return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-				}
-
-				createReq.Header.Set("Content-Type", "application/json")
-				createReq.Header.Set("Accept", "application/json")
-
-				createResp, err := p.client.http.Do(createReq)
-				if err != nil {
-					return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-				}
-				defer createResp.Body.Close()
-
-				if createResp.StatusCode >= 400 {
-					bodyBytes, _ := io.ReadAll(createResp.Body)
-					return retry.RetryableError(fmt.Errorf("error recreating registry (status %d): %s", createResp.StatusCode, string(bodyBytes)))
-				}
-
-				// Continue with the response processing using the create response
-				resp = createResp
-			} else {
-				// Something else is wrong with the API
-				bodyBytes, _ := io.ReadAll(readResp.Body)
-				return retry.RetryableError(fmt.Errorf("error listing registries (status %d): %s", readResp.StatusCode, string(bodyBytes)))
-			}
-		} else if resp.StatusCode >= 400 {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			return retry.RetryableError(fmt.Errorf("error updating registry (status %d): %s", resp.StatusCode, string(bodyBytes)))
-		}
-
-		var result struct {
-			GraphQLID   string   `json:"graphql_id"`
-			ID          string   `json:"id"`
-			Name        string   `json:"name"`
-			Slug        string   `json:"slug"`
-			Ecosystem   string   `json:"ecosystem"`
-			Description string   `json:"description,omitempty"`
-			Emoji       string   `json:"emoji,omitempty"`
-			Color       string   `json:"color,omitempty"`
-			OIDCPolicy  string   `json:"oidc_policy,omitempty"`
-			TeamIDs     []string `json:"team_ids,omitempty"`
-		}
-
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
-		}
-
-		// Use GraphQL ID for the Terraform resource ID, UUID is for API lookups
-		plan.ID = types.StringValue(result.GraphQLID)
-		plan.UUID = types.StringValue(result.ID)
-		plan.Slug = types.StringValue(result.Slug)
-		plan.Name = types.StringValue(result.Name)
-		plan.Ecosystem = types.StringValue(result.Ecosystem)
-
-		if result.Description != "" {
-			plan.Description = types.StringValue(result.Description)
-		} else {
-			plan.Description = types.StringNull()
-		}
-
-		if result.Emoji != "" {
-			plan.Emoji = types.StringValue(result.Emoji)
-		} else {
-			plan.Emoji = types.StringNull()
-		}
-
-		if result.Color != "" {
-			plan.Color = types.StringValue(result.Color)
-		} else {
-			plan.Color = types.StringNull()
-		}
-
-		if result.OIDCPolicy != "" {
-			plan.OIDCPolicy = types.StringValue(result.OIDCPolicy)
-		} else {
-			plan.OIDCPolicy = types.StringNull()
+			return retryContextError(err)
 		}
 
-		// Handle team_ids in the response using the helper function
-		plan.TeamIDs = handleTeamIDs(result.TeamIDs, plan.TeamIDs)
-
+		setRegistryModel(plan, result)
 		return nil
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating registry",
-			fmt.Sprintf("Could not update registry: %s", err),
+			fmt.Sprintf("Could not update registry: %s", p.client.describeScopedError(err, "write_registries")),
 		)
 		return
 	}
@@ -845,6 +441,10 @@ func (p *registryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !checkDeletion("registry", state.DeletionProtection, state.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
 	timeout, diags := p.client.timeouts.Delete(ctx, DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
 
@@ -852,108 +452,45 @@ func (p *registryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// First, ensure we have UUID set in state if possible
+	// The registry is deleted by slug, so find it by name if the slug is missing from state
 	if state.Slug.IsNull() || state.Slug.ValueString() == "" {
-		// We need to find the registry by name to get its slug first
 		readTimeout, _ := p.client.timeouts.Read(ctx, DefaultTimeout)
 
-		_ = retry.RetryContext(ctx, readTimeout, func() *retry.RetryError {
-			url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries", p.client.restURL, p.client.organization)
-
-			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
-			}
-
-			req.Header.Set("Accept", "application/json")
-
-			resp, err := p.client.http.Do(req)
-			if err != nil {
-				return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode >= 400 {
-				bodyBytes, _ := io.ReadAll(resp.Body)
-				return retry.RetryableError(fmt.Errorf("error listing registries (status %d): %s", resp.StatusCode, string(bodyBytes)))
-			}
-
-			bodyBytes, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error reading response body: %w", err))
-			}
-
-			var registries []struct {
-				GraphQLID string `json:"graphql_id"`
-				ID        string `json:"id"`
-				Slug      string `json:"slug"`
-				Name      string `json:"name"`
-				Ecosystem string `json:"ecosystem"`
-			}
-
-			if err := json.Unmarshal(bodyBytes, &registries); err != nil {
-				return retry.NonRetryableError(fmt.Errorf("error decoding response body: %w", err))
-			}
-
-			// Find registry by name
-			for _, registry := range registries {
-				if registry.Name == state.Name.ValueString() {
-					state.Slug = types.StringValue(registry.Slug)
-					return nil
-				}
+		err := retry.RetryContext(ctx, readTimeout, func() *retry.RetryError {
+			registry, err := p.findRegistryByName(ctx, state.Name.ValueString())
+			if registry != nil {
+				state.Slug = types.StringValue(registry.Slug)
 			}
-
-			// If we didn't find the registry, it might already be deleted
-			return nil
+			return retryContextError(err)
 		})
-	}
-
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		// Try to use UUID for lookup if it's available
-		var lookupID string
-
-		if !state.Slug.IsNull() && state.Slug.ValueString() != "" {
-			lookupID = state.Slug.ValueString()
-		} else {
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error deleting registry",
-				"Registry slug is not set in state. Please re-import the resource.",
+				fmt.Sprintf("Could not find registry to delete: %s", p.client.describeScopedError(err, "read_registries")),
 			)
-			return nil
+			return
 		}
 
-		url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries/%s", p.client.restURL, p.client.organization, lookupID)
-
-		// Create the HTTP request
-		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error creating HTTP request: %w", err))
+		// If we didn't find the registry, it has already been deleted
+		if state.Slug.IsNull() || state.Slug.ValueString() == "" {
+			resp.State.RemoveResource(ctx)
+			return
 		}
+	}
 
-		// Execute the HTTP request
-		resp, err := p.client.http.Do(req)
-		if err != nil {
-			return retry.RetryableError(fmt.Errorf("error making HTTP request: %w", err))
-		}
-		defer resp.Body.Close()
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := restDelete(ctx, p.client, p.registryPath(state.Slug.ValueString()))
 
 		// If the registry was already deleted, consider the delete successful
-		if resp.StatusCode == http.StatusNotFound {
+		if isNotFoundError(err) {
 			return nil
 		}
-
-		// Check for successful response
-		if resp.StatusCode >= 400 {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			return retry.RetryableError(fmt.Errorf("error deleting registry (status %d): %s", resp.StatusCode, string(bodyBytes)))
-		}
-
-		return nil
+		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting registry",
-			fmt.Sprintf("Could not delete registry: %s", err),
+			fmt.Sprintf("Could not delete registry: %s", p.client.describeScopedError(err, "delete_registries")),
 		)
 		return
 	}
@@ -977,24 +514,41 @@ func (r *registryResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
-	// Get the current state
-	if req.State.Raw.IsNull() {
-		// No state means this is a create, nothing to do
-		return
-	}
-
+	// Get the current state, no state means this is a create
 	var state registryResourceModel
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The slug is only regenerated by the API when the name changes
+		if plan.Name.Equal(state.Name) {
+			return
+		}
 	}
 
-	// Check if name has changed
-	if !plan.Name.Equal(state.Name) {
-		// If name changed, mark slug as unknown since it will be regenerated by the API
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("slug"), types.StringUnknown())...)
+	// Predict the slug the API derives from the name, leaving it unknown if that isn't possible or another registry
+	// already uses it, and warning when that cannot be checked
+	planSlug := types.StringUnknown()
+	if r.client != nil && !plan.Name.IsUnknown() && slug.Predictable(plan.Name.ValueString()) {
+		derived := slug.FromName(plan.Name.ValueString())
+		lookupCtx, cancel := withPlanLookup(ctx)
+		defer cancel()
+		existing, err := restGet[registryResponse](lookupCtx, r.client, r.registryPath(derived))
+		switch {
+		case isNotFoundError(err), err == nil && existing.GraphQLID == state.ID.ValueString():
+			planSlug = types.StringValue(derived)
+		case err != nil:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("slug"),
+				"Unable to check registry slug",
+				fmt.Sprintf("Could not check whether the slug %q derived from the name is already used, so it will be known after apply: %s", derived, describeError(err)),
+			)
+		}
 	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("slug"), planSlug)...)
 }
 
 func (r *registryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
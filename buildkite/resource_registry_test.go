@@ -7,9 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccResourceRegistry(t *testing.T) {
@@ -75,6 +79,69 @@ func TestAccResourceRegistry(t *testing.T) {
 		})
 	})
 
+	t.Run("plans the derived slug when renaming", func(t *testing.T) {
+		randName := fmt.Sprintf("registry-%s", acctest.RandString(5))
+		renamed := fmt.Sprintf("Renamed Registry %s", acctest.RandString(5))
+		ecosystem := "java"
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckRegistryDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, ecosystem, ":buildkite:"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectKnownValue("buildkite_registry.test", tfjsonpath.New("slug"), knownvalue.StringExact(slug.FromName(randName))),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("buildkite_registry.test", "slug", slug.FromName(randName)),
+				},
+				{
+					Config: config(renamed, ecosystem, ":buildkite:"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_registry.test", plancheck.ResourceActionUpdate),
+							plancheck.ExpectKnownValue("buildkite_registry.test", tfjsonpath.New("slug"), knownvalue.StringExact(slug.FromName(renamed))),
+						},
+					},
+					Check: resource.TestCheckResourceAttr("buildkite_registry.test", "slug", slug.FromName(renamed)),
+				},
+			},
+		})
+	})
+
+	t.Run("recreates a registry deleted outside of terraform", func(t *testing.T) {
+		var r registryResourceModel
+		randName := acctest.RandString(5)
+		ecosystem := "java"
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			CheckDestroy:             testAccCheckRegistryDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, ecosystem, ":bazel:"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						testAccCheckRegistryExists("buildkite_registry.test", &r),
+						testAccDeleteRegistry("buildkite_registry.test"),
+					),
+					// the registry is deleted by the check, so the refresh afterwards finds it missing
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: config(randName, ecosystem, ":bazel:"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						testAccCheckRegistryExists("buildkite_registry.test", &r),
+						resource.TestCheckResourceAttr("buildkite_registry.test", "name", randName),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("import", func(t *testing.T) {
 		var r registryResourceModel
 		randName := acctest.RandString(5)
@@ -164,6 +231,40 @@ func testAccCheckRegistryDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccDeleteRegistry deletes a registry through the REST API, as if it had been removed outside of Terraform
+func testAccDeleteRegistry(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		slug := rs.Primary.Attributes["slug"]
+
+		baseURL := os.Getenv("BUILDKITE_API_URL")
+		if baseURL == "" {
+			baseURL = "https://api.buildkite.com"
+		}
+		url := fmt.Sprintf("%s/v2/packages/organizations/%s/registries/%s", baseURL, os.Getenv("BUILDKITE_ORGANIZATION_SLUG"), slug)
+
+		req, err := http.NewRequest(http.MethodDelete, url, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("BUILDKITE_API_TOKEN")))
+
+		resp, err := (&http.Client{Timeout: time.Second * 10}).Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("error deleting registry %s (status %d)", slug, resp.StatusCode)
+		}
+		return nil
+	}
+}
+
 func testAccCheckRegistryExists(n string, r *registryResourceModel) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
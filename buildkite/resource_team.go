@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"log"
 
 	custom_modifier "github.com/buildkite/terraform-provider-buildkite/internal/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -33,6 +34,9 @@ type teamResourceModel struct {
 	DefaultMemberRole         types.String `tfsdk:"default_member_role"`
 	Slug                      types.String `tfsdk:"slug"`
 	MembersCanCreatePipelines types.Bool   `tfsdk:"members_can_create_pipelines"`
+
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
 }
 
 // This is required due to the getTeam function not using Genqlient
@@ -124,12 +128,46 @@ func (t *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether members of the team can create Pipelines.",
 			},
+			"deletion_protection": deletionProtectionAttribute("team"),
+			"deletion_policy":     deletionPolicyAttribute("team"),
 		},
 	}
 }
 
 func (t *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "Team") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// otherwise the import identifier is the slug of the team
+	id, err := findTeamID(ctx, t.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import team",
+			fmt.Sprintf("Unable to read team %s: %s", req.ID, describeError(err)),
+		)
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unable to import team", fmt.Sprintf("Could not find team with slug %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findTeamID returns the GraphQL ID of the team with the given slug, or an empty string when there is none
+func findTeamID(ctx context.Context, client *Client, slug string) (string, error) {
+	log.Printf("Obtaining team with slug %s ...", slug)
+	r, err := GetTeamFromSlug(ctx, client.genqlient, fmt.Sprintf("%s/%s", client.organization, slug))
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return r.Team.Id, nil
 }
 
 func (t *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -210,9 +248,12 @@ func (t *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Team", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read team.",
-			fmt.Sprintf("Unable to read team: %s", err.Error()),
+			fmt.Sprintf("Unable to read team: %s", describeError(err)),
 		)
 		return
 	}
@@ -226,6 +267,7 @@ func (t *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		}
 		updateTeamResourceState(&state, *teamNode)
+		setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
 		// Resource not found, remove from state
@@ -291,6 +333,10 @@ func (t *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if !checkDeletion("team", state.DeletionProtection, state.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
 	timeout, diags := t.client.timeouts.Delete(ctx, DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
 
@@ -303,7 +349,7 @@ func (t *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 			t.client.genqlient,
 			state.ID.ValueString(),
 		)
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -312,7 +358,7 @@ func (t *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Team",
-			fmt.Sprintf("Unable to delete Team: %s", err.Error()),
+			fmt.Sprintf("Unable to delete Team: %s", describeError(err)),
 		)
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -157,9 +158,12 @@ func (tm *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Team member", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read team member",
-			fmt.Sprintf("Unable to read ream member: %s", err.Error()),
+			fmt.Sprintf("Unable to read ream member: %s", describeError(err)),
 		)
 		return
 	}
@@ -183,7 +187,50 @@ func (tm *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (tm *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "TeamMember") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	teamSlug, email, ok := splitImportID(req.ID)
+	if !ok {
+		addImportIDError(&resp.Diagnostics, req.ID, "id", "team_slug/user_email")
+		return
+	}
+
+	var cursor *string
+	for {
+		log.Printf("Getting members of team %s ...", teamSlug)
+		r, err := getTeamMembers(ctx, tm.client.genqlient, fmt.Sprintf("%s/%s", tm.client.organization, teamSlug), cursor)
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Unable to import team member",
+				fmt.Sprintf("Unable to read members of team %s: %s", teamSlug, describeError(err)),
+			)
+			return
+		}
+		if r == nil {
+			break
+		}
+
+		// email addresses are case insensitive
+		for _, edge := range r.Team.Members.Edges {
+			if strings.EqualFold(edge.Node.User.Email, email) {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), edge.Node.Id)...)
+				return
+			}
+		}
+
+		if !r.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &r.Team.Members.PageInfo.EndCursor
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to import team member",
+		fmt.Sprintf("Could not find member with email %q in team %q", email, teamSlug),
+	)
 }
 
 func (tm *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -255,7 +302,7 @@ func (tm *teamMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 			tm.client.genqlient,
 			state.Id.ValueString(),
 		)
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -264,7 +311,7 @@ func (tm *teamMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete team member",
-			fmt.Sprintf("Unable to delete team member: %s", err.Error()),
+			fmt.Sprintf("Unable to delete team member: %s", describeError(err)),
 		)
 		return
 	}
@@ -153,6 +153,13 @@ func TestAccBuildkiteTeamMember(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the resource by the slug of the team and the email of the user
+					ResourceName:      "buildkite_team_member.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportTeamMemberID("buildkite_team.test", "buildkite_team_member.test"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -214,6 +221,38 @@ func testAccCheckTeamMemberExists(resourceName string, tm *teamMemberResourceMod
 	}
 }
 
+// testAccImportTeamMemberID builds the team_slug/user_email import identifier of a team member, looking up the email
+// of its user in the organization
+func testAccImportTeamMemberID(teamName, teamMemberName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		team, ok := s.RootModule().Resources[teamName]
+		if !ok {
+			return "", fmt.Errorf("Not found in state: %s", teamName)
+		}
+		teamMember, ok := s.RootModule().Resources[teamMemberName]
+		if !ok {
+			return "", fmt.Errorf("Not found in state: %s", teamMemberName)
+		}
+
+		var cursor *string
+		for {
+			members, err := GetOrganizationMembers(context.Background(), genqlientGraphql, getenv("BUILDKITE_ORGANIZATION_SLUG"), cursor)
+			if err != nil {
+				return "", fmt.Errorf("Error fetching organization members from graphql API: %v", err)
+			}
+			for _, member := range members.Organization.Members.Edges {
+				if member.Node.User.Id == teamMember.Primary.Attributes["user_id"] {
+					return fmt.Sprintf("%s/%s", team.Primary.Attributes["slug"], member.Node.User.Email), nil
+				}
+			}
+			if !members.Organization.Members.PageInfo.HasNextPage {
+				return "", fmt.Errorf("User %s is not a member of the organization", teamMember.Primary.Attributes["user_id"])
+			}
+			cursor = &members.Organization.Members.PageInfo.EndCursor
+		}
+	}
+}
+
 // verify the team member has been removed
 func testCheckTeamMemberResourceRemoved(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,669 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type teamMembersResourceModel struct {
+	Id                types.String             `tfsdk:"id"`
+	TeamId            types.String             `tfsdk:"team_id"`
+	IgnoreMaintainers types.Bool               `tfsdk:"ignore_maintainers"`
+	Members           []teamMembersMemberModel `tfsdk:"members"`
+}
+
+type teamMembersMemberModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+// teamMembership is a member of a team as returned by the API
+type teamMembership struct {
+	id     string
+	userId string
+	email  string
+	role   string
+}
+
+type teamMembersResource struct {
+	client *Client
+}
+
+var (
+	_ resource.ResourceWithValidateConfig = &teamMembersResource{}
+	_ resource.ResourceWithModifyPlan     = &teamMembersResource{}
+	_ resource.ResourceWithImportState    = &teamMembersResource{}
+)
+
+func newTeamMembersResource() resource.Resource {
+	return &teamMembersResource{}
+}
+
+func (teamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (tm *teamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tm.client = req.ProviderData.(*Client)
+}
+
+func (teamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_schema.Schema{
+		MarkdownDescription: heredoc.Docf(`
+			A team members resource manages the complete membership of a team. Users added to the team outside of
+			Terraform are removed on the next apply, unless they are maintainers and %s is set.
+
+			Use either this resource or %s for a team, not both, as this resource removes the members the other adds.
+		`, "`ignore_maintainers`", "`buildkite_team_member`"),
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GraphQL ID of the team.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": resource_schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The GraphQL ID of the team.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_maintainers": resource_schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Leave maintainers of the team that are not in `members` in place rather than removing them. Defaults to `false`.",
+			},
+			"members": resource_schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The complete set of members of the team. Each member is identified by either `user_id` or `email`, and each user can only be listed once.",
+				NestedObject: resource_schema.NestedAttributeObject{
+					Attributes: map[string]resource_schema.Attribute{
+						"user_id": resource_schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The GraphQL ID of the user.",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("email")),
+							},
+						},
+						"email": resource_schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The email address of the user.",
+						},
+						"role": resource_schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The role for the user. Either `MEMBER` or `MAINTAINER`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("MEMBER", "MAINTAINER"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (tm *teamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := tm.client.timeouts.Create(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tm.reconcile(ctx, timeout, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.TeamId
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (tm *teamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := tm.client.timeouts.Read(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberships []teamMembership
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		memberships, err = tm.listMembers(ctx, state.TeamId.ValueString())
+		return retryContextError(err)
+	})
+	if err != nil {
+		if removeIfNotFound(ctx, err, "Team", resp) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read team members",
+			fmt.Sprintf("Unable to read team members: %s", describeError(err)),
+		)
+		return
+	}
+	if memberships == nil {
+		resp.Diagnostics.AddWarning("Team not found", "Removing team members from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.IgnoreMaintainers.IsNull() {
+		state.IgnoreMaintainers = types.BoolValue(false)
+	}
+	state.Id = state.TeamId
+	state.Members = teamMembersFromAPI(state.Members, memberships, state.IgnoreMaintainers.ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (tm *teamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := tm.client.timeouts.Update(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(tm.reconcile(ctx, timeout, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.TeamId
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the members in state from the team. Members that were ignored are left in place.
+func (tm *teamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := tm.client.timeouts.Delete(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberships []teamMembership
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		memberships, err = tm.listMembers(ctx, state.TeamId.ValueString())
+		return retryContextError(err)
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Unable to read team members",
+			fmt.Sprintf("Unable to read team members: %s", describeError(err)),
+		)
+		return
+	}
+
+	for _, membership := range memberships {
+		if findTeamMember(state.Members, membership) == nil {
+			continue
+		}
+
+		log.Printf("Deleting team member with ID %s ...", membership.id)
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err := deleteTeamMember(ctx, tm.client.genqlient, membership.id)
+			if err != nil && isNotFoundError(err) {
+				return nil
+			}
+			return retryContextError(err)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to delete team member",
+				fmt.Sprintf("Unable to delete team member %s: %s", membership.email, describeError(err)),
+			)
+			return
+		}
+	}
+}
+
+// ImportState accepts the GraphQL ID or slug of a team, bringing all of its current members into state. Members are
+// identified by user_id, or by email when the ID ends in /email, so they match how they are configured.
+func (tm *teamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	team, identifier := req.ID, "user_id"
+	if i := strings.LastIndex(req.ID, "/"); i >= 0 && (req.ID[i+1:] == "user_id" || req.ID[i+1:] == "email") {
+		team, identifier = req.ID[:i], req.ID[i+1:]
+	}
+
+	id := team
+	if !isGraphQLID(team, "Team") {
+		var err error
+		id, err = findTeamID(ctx, tm.client, team)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import team members",
+				fmt.Sprintf("Unable to read team %s: %s", team, describeError(err)),
+			)
+			return
+		}
+		if id == "" {
+			resp.Diagnostics.AddError("Unable to import team members", fmt.Sprintf("Could not find team with slug %q", team))
+			return
+		}
+	}
+
+	timeout, diags := tm.client.timeouts.Read(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberships []teamMembership
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		memberships, err = tm.listMembers(ctx, id)
+		return retryContextError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import team members",
+			fmt.Sprintf("Unable to read team members: %s", describeError(err)),
+		)
+		return
+	}
+	if memberships == nil {
+		resp.Diagnostics.AddError("Unable to import team members", fmt.Sprintf("Could not find team with ID %q", id))
+		return
+	}
+
+	members := make([]teamMembersMemberModel, 0, len(memberships))
+	for _, membership := range memberships {
+		member := teamMembersMemberModel{
+			UserId: types.StringNull(),
+			Email:  types.StringNull(),
+			Role:   types.StringValue(membership.role),
+		}
+		if identifier == "email" {
+			member.Email = types.StringValue(membership.email)
+		} else {
+			member.UserId = types.StringValue(membership.userId)
+		}
+		members = append(members, member)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_maintainers"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), members)...)
+}
+
+// ValidateConfig rejects users that are listed more than once with the same identifier
+func (tm *teamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	members, diags := knownTeamMembers(ctx, req.Config.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+
+	for _, duplicate := range duplicateTeamMembers(members) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("members"),
+			"Duplicate team member",
+			fmt.Sprintf("The user %s is listed more than once in members. Each user can only have one role in a team.", duplicate),
+		)
+	}
+}
+
+// ModifyPlan rejects users that are listed by both their user_id and their email, which can only be found by looking
+// the emails up. A failed lookup is a warning, as the duplicate is still caught when the members are applied.
+func (tm *teamMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || tm.client == nil {
+		return
+	}
+
+	members, diags := knownTeamMembers(ctx, req.Plan.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+
+	userIds := make(map[string]bool)
+	var emails []string
+	for _, member := range members {
+		if !member.UserId.IsNull() {
+			userIds[member.UserId.ValueString()] = true
+		} else {
+			emails = append(emails, member.Email.ValueString())
+		}
+	}
+	if len(userIds) == 0 || len(emails) == 0 {
+		return
+	}
+
+	ctx, cancel := withPlanLookup(ctx)
+	defer cancel()
+
+	for _, email := range emails {
+		r, err := GetOrganizationMemberByEmail(ctx, tm.client.genqlient, tm.client.organization, email)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("members"),
+				"Unable to check for duplicate team members",
+				fmt.Sprintf("Could not look up the user with email %q to check it is not also listed by user_id: %s", email, describeError(err)),
+			)
+			return
+		}
+		if len(r.Organization.Members.Edges) > 0 && userIds[r.Organization.Members.Edges[0].Node.User.Id] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Duplicate team member",
+				fmt.Sprintf("The user with email %q is also listed by its user_id in members. Each user can only have one role in a team.", email),
+			)
+		}
+	}
+}
+
+// knownTeamMembers returns the members whose identifiers are known, read with get from the config or plan
+func knownTeamMembers(ctx context.Context, get func(context.Context, path.Path, any) diag.Diagnostics) ([]teamMembersMemberModel, diag.Diagnostics) {
+	var set types.Set
+	diags := get(ctx, path.Root("members"), &set)
+	if diags.HasError() || set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+
+	var members []teamMembersMemberModel
+	for _, element := range set.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var member teamMembersMemberModel
+		diags.Append(object.As(ctx, &member, basetypes.ObjectAsOptions{})...)
+		if member.UserId.IsUnknown() || member.Email.IsUnknown() || (member.UserId.IsNull() && member.Email.IsNull()) {
+			continue
+		}
+		members = append(members, member)
+	}
+	return members, diags
+}
+
+// duplicateTeamMembers returns the identifiers of users listed more than once with the same identifier. Email
+// addresses are case insensitive.
+func duplicateTeamMembers(members []teamMembersMemberModel) []string {
+	seen := make(map[string]bool)
+	var duplicates []string
+	for _, member := range members {
+		key := "user_id:" + member.UserId.ValueString()
+		if member.UserId.IsNull() {
+			key = "email:" + strings.ToLower(member.Email.ValueString())
+		}
+		if seen[key] {
+			duplicates = append(duplicates, teamMemberIdentifier(member))
+		}
+		seen[key] = true
+	}
+	return duplicates
+}
+
+// reconcile adds, updates and removes members of the team until its membership matches the plan
+func (tm *teamMembersResource) reconcile(ctx context.Context, timeout time.Duration, plan teamMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var memberships []teamMembership
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		memberships, err = tm.listMembers(ctx, plan.TeamId.ValueString())
+		return retryContextError(err)
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to read team members",
+			fmt.Sprintf("Unable to read team members: %s", describeError(err)),
+		)
+		return diags
+	}
+	if memberships == nil {
+		diags.AddAttributeError(
+			path.Root("team_id"),
+			"Unable to find team",
+			fmt.Sprintf("Could not find team with ID \"%s\"", plan.TeamId.ValueString()),
+		)
+		return diags
+	}
+
+	for _, member := range plan.Members {
+		var current *teamMembership
+		for i := range memberships {
+			if teamMemberMatches(member, memberships[i]) {
+				current = &memberships[i]
+				break
+			}
+		}
+
+		role := member.Role.ValueString()
+		switch {
+		case current != nil && current.role == role:
+			continue
+		case current != nil:
+			log.Printf("Updating team member %s with role %s ...", current.email, role)
+			err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+				_, err := updateTeamMember(ctx, tm.client.genqlient, current.id, role)
+				return retryContextError(err)
+			})
+		default:
+			var userId string
+			userId, err = tm.findUserID(ctx, timeout, member)
+			if err == nil && userId == "" {
+				diags.AddAttributeError(
+					path.Root("members"),
+					"Unable to find user",
+					fmt.Sprintf("Could not find a member of the organization with email %q", member.Email.ValueString()),
+				)
+				return diags
+			}
+			if err == nil {
+				log.Printf("Adding team member %s with role %s ...", userId, role)
+				err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+					_, err := createTeamMember(ctx, tm.client.genqlient, plan.TeamId.ValueString(), userId, role)
+					return retryContextError(err)
+				})
+			}
+		}
+		if err != nil {
+			diags.AddError(
+				"Unable to update team members",
+				fmt.Sprintf("Unable to update team member %s: %s", teamMemberIdentifier(member), describeError(err)),
+			)
+			return diags
+		}
+	}
+
+	// remove unmanaged members last, so the team is never left without its maintainers part way through
+	for _, membership := range memberships {
+		if findTeamMember(plan.Members, membership) != nil {
+			continue
+		}
+		if plan.IgnoreMaintainers.ValueBool() && membership.role == "MAINTAINER" {
+			continue
+		}
+
+		log.Printf("Removing unmanaged team member %s ...", membership.email)
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err := deleteTeamMember(ctx, tm.client.genqlient, membership.id)
+			if err != nil && isNotFoundError(err) {
+				return nil
+			}
+			return retryContextError(err)
+		})
+		if err != nil {
+			diags.AddError(
+				"Unable to delete team member",
+				fmt.Sprintf("Unable to delete team member %s: %s", membership.email, describeError(err)),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// listMembers returns every member of a team, or nil when the team can't be found
+func (tm *teamMembersResource) listMembers(ctx context.Context, teamId string) ([]teamMembership, error) {
+	log.Printf("Reading team %s ...", teamId)
+	r, err := getNode(ctx, tm.client.genqlient, teamId)
+	if err != nil {
+		return nil, err
+	}
+	team, ok := r.GetNode().(*getNodeNodeTeam)
+	if !ok || team == nil {
+		return nil, nil
+	}
+
+	memberships := []teamMembership{}
+	var cursor *string
+	for {
+		log.Printf("Getting members of team %s ...", team.Slug)
+		r, err := getTeamMembers(ctx, tm.client.genqlient, fmt.Sprintf("%s/%s", tm.client.organization, team.Slug), cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range r.Team.Members.Edges {
+			memberships = append(memberships, teamMembership{
+				id:     edge.Node.Id,
+				userId: edge.Node.User.Id,
+				email:  edge.Node.User.Email,
+				role:   string(edge.Node.Role),
+			})
+		}
+
+		if !r.Team.Members.PageInfo.HasNextPage {
+			return memberships, nil
+		}
+		cursor = &r.Team.Members.PageInfo.EndCursor
+	}
+}
+
+// findUserID returns the GraphQL ID of the user for a member, looking it up by email when needed
+func (tm *teamMembersResource) findUserID(ctx context.Context, timeout time.Duration, member teamMembersMemberModel) (string, error) {
+	if !member.UserId.IsNull() {
+		return member.UserId.ValueString(), nil
+	}
+
+	var r *GetOrganizationMemberByEmailResponse
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		log.Printf("Getting organization member with email %s ...", member.Email.ValueString())
+		r, err = GetOrganizationMemberByEmail(ctx, tm.client.genqlient, tm.client.organization, member.Email.ValueString())
+		return retryContextError(err)
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(r.Organization.Members.Edges) == 0 {
+		return "", nil
+	}
+	return r.Organization.Members.Edges[0].Node.User.Id, nil
+}
+
+// teamMembersFromAPI builds the members for state from the current members of the team. Members already in state
+// keep the identifier they were configured with, and the rest are added with both identifiers so they show as
+// removals in the next plan.
+func teamMembersFromAPI(members []teamMembersMemberModel, memberships []teamMembership, ignoreMaintainers bool) []teamMembersMemberModel {
+	result := []teamMembersMemberModel{}
+	for _, membership := range memberships {
+		if member := findTeamMember(members, membership); member != nil {
+			result = append(result, teamMembersMemberModel{
+				UserId: member.UserId,
+				Email:  member.Email,
+				Role:   types.StringValue(membership.role),
+			})
+			continue
+		}
+		if ignoreMaintainers && membership.role == "MAINTAINER" {
+			continue
+		}
+
+		result = append(result, teamMembersMemberModel{
+			UserId: types.StringValue(membership.userId),
+			Email:  types.StringValue(membership.email),
+			Role:   types.StringValue(membership.role),
+		})
+	}
+	return result
+}
+
+func findTeamMember(members []teamMembersMemberModel, membership teamMembership) *teamMembersMemberModel {
+	for i := range members {
+		if teamMemberMatches(members[i], membership) {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+// teamMemberMatches reports whether a configured member is the user of a membership. Email addresses are case
+// insensitive.
+func teamMemberMatches(member teamMembersMemberModel, membership teamMembership) bool {
+	if !member.UserId.IsNull() {
+		return member.UserId.ValueString() == membership.userId
+	}
+	return strings.EqualFold(member.Email.ValueString(), membership.email)
+}
+
+func teamMemberIdentifier(member teamMembersMemberModel) string {
+	if !member.UserId.IsNull() {
+		return member.UserId.ValueString()
+	}
+	return member.Email.ValueString()
+}
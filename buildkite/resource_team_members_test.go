@@ -0,0 +1,260 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// the user referenced by the team member acceptance tests
+const teamMembersTestUserID = "VXNlci0tLThkYjI5MjBlLTNjNjAtNDhhNy1hM2Y4LTI1ODRiZTM3NGJhYw=="
+
+func TestAccBuildkiteTeamMembers(t *testing.T) {
+	config := func(name, members string, ignoreMaintainers bool) string {
+		return fmt.Sprintf(`
+		provider "buildkite" {
+			timeouts = {
+				create = "10s"
+				read = "10s"
+				update = "10s"
+				delete = "10s"
+			}
+		}
+
+		resource "buildkite_team" "test" {
+			name = "acceptance testing %s"
+			privacy = "VISIBLE"
+			default_team = false
+			default_member_role = "MEMBER"
+		}
+
+		resource "buildkite_team_members" "test" {
+			team_id = buildkite_team.test.id
+			ignore_maintainers = %t
+			members = %s
+		}
+		`, name, ignoreMaintainers, members)
+	}
+
+	t.Run("manages the members of a team", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, `[{ email = "Terraform@example.com", role = "MEMBER" }]`, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("buildkite_team_members.test", "id", "buildkite_team.test", "id"),
+						resource.TestCheckResourceAttr("buildkite_team_members.test", "members.#", "1"),
+						testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{teamMembersTestUserID: "MEMBER"}),
+					),
+				},
+				{
+					Config: config(randName, `[{ email = "Terraform@example.com", role = "MAINTAINER" }]`, false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_team_members.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{teamMembersTestUserID: "MAINTAINER"}),
+				},
+				{
+					Config: config(randName, fmt.Sprintf(`[{ user_id = %q, role = "MAINTAINER" }]`, teamMembersTestUserID), false),
+					Check:  testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{teamMembersTestUserID: "MAINTAINER"}),
+				},
+				{
+					Config: config(randName, `[]`, false),
+					Check:  testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{}),
+				},
+			},
+		})
+	})
+
+	t.Run("removes members added outside of terraform", func(t *testing.T) {
+		randName := acctest.RandString(10)
+		var teamID string
+		addMember := func(role string) func() {
+			return func() {
+				if _, err := createTeamMember(context.Background(), genqlientGraphql, teamID, teamMembersTestUserID, role); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, `[]`, false),
+					Check: func(s *terraform.State) error {
+						teamID = s.RootModule().Resources["buildkite_team_members.test"].Primary.ID
+						return nil
+					},
+				},
+				{
+					PreConfig: addMember("MEMBER"),
+					Config:    config(randName, `[]`, false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("buildkite_team_members.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{}),
+				},
+				{
+					PreConfig: addMember("MAINTAINER"),
+					Config:    config(randName, `[]`, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("buildkite_team_members.test", "members.#", "0"),
+						testAccCheckTeamMembersRemote("buildkite_team_members.test", map[string]string{teamMembersTestUserID: "MAINTAINER"}),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("imports the members of a team by slug", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, fmt.Sprintf(`[{ user_id = %q, role = "MEMBER" }]`, teamMembersTestUserID), false),
+				},
+				{
+					ResourceName:      "buildkite_team_members.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_team.test.slug"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("imports the members of a team by email", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config(randName, `[{ email = "terraform@example.com", role = "MEMBER" }]`, false),
+				},
+				{
+					ResourceName:      "buildkite_team_members.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s/email", "buildkite_team.test.slug"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("rejects users listed more than once", func(t *testing.T) {
+		randName := acctest.RandString(10)
+
+		resource.ParallelTest(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: protoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      config(randName, `[{ email = "terraform@example.com", role = "MEMBER" }, { email = "Terraform@example.com", role = "MAINTAINER" }]`, false),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("(?i)The user terraform@example.com is listed more than once"),
+				},
+				{
+					Config: config(randName, fmt.Sprintf(`[{ email = "terraform@example.com", role = "MEMBER" }, { user_id = %q, role = "MAINTAINER" }]`,
+						teamMembersTestUserID), false),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`The user with email "terraform@example.com" is also listed by its user_id`),
+				},
+			},
+		})
+	})
+}
+
+func testAccCheckTeamMembersRemote(name string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found in state: %s", name)
+		}
+
+		tm := &teamMembersResource{client: &Client{genqlient: genqlientGraphql, organization: getenv("BUILDKITE_ORGANIZATION_SLUG")}}
+		memberships, err := tm.listMembers(context.Background(), rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+
+		actual := make(map[string]string, len(memberships))
+		for _, membership := range memberships {
+			actual[membership.userId] = membership.role
+		}
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			return fmt.Errorf("unexpected team members: %s", diff)
+		}
+		return nil
+	}
+}
+
+func TestTeamMembersFromAPI(t *testing.T) {
+	memberships := []teamMembership{
+		{id: "1", userId: "user-1", email: "one@example.com", role: "MEMBER"},
+		{id: "2", userId: "user-2", email: "two@example.com", role: "MAINTAINER"},
+		{id: "3", userId: "user-3", email: "three@example.com", role: "MEMBER"},
+	}
+	configured := []teamMembersMemberModel{
+		{UserId: types.StringNull(), Email: types.StringValue("ONE@example.com"), Role: types.StringValue("MAINTAINER")},
+		{UserId: types.StringValue("user-3"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+		{UserId: types.StringValue("user-4"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+	}
+
+	t.Run("keeps configured identifiers and adds unmanaged members", func(t *testing.T) {
+		expected := []teamMembersMemberModel{
+			{UserId: types.StringNull(), Email: types.StringValue("ONE@example.com"), Role: types.StringValue("MEMBER")},
+			{UserId: types.StringValue("user-2"), Email: types.StringValue("two@example.com"), Role: types.StringValue("MAINTAINER")},
+			{UserId: types.StringValue("user-3"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+		}
+		if diff := cmp.Diff(expected, teamMembersFromAPI(configured, memberships, false)); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("leaves out unmanaged maintainers when ignored", func(t *testing.T) {
+		expected := []teamMembersMemberModel{
+			{UserId: types.StringNull(), Email: types.StringValue("ONE@example.com"), Role: types.StringValue("MEMBER")},
+			{UserId: types.StringValue("user-3"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+		}
+		if diff := cmp.Diff(expected, teamMembersFromAPI(configured, memberships, true)); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestDuplicateTeamMembers(t *testing.T) {
+	members := []teamMembersMemberModel{
+		{UserId: types.StringNull(), Email: types.StringValue("one@example.com"), Role: types.StringValue("MEMBER")},
+		{UserId: types.StringValue("user-2"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+		{UserId: types.StringNull(), Email: types.StringValue("ONE@example.com"), Role: types.StringValue("MAINTAINER")},
+		{UserId: types.StringValue("user-2"), Email: types.StringNull(), Role: types.StringValue("MAINTAINER")},
+		{UserId: types.StringValue("user-3"), Email: types.StringNull(), Role: types.StringValue("MEMBER")},
+	}
+
+	if diff := cmp.Diff([]string{"ONE@example.com", "user-2"}, duplicateTeamMembers(members)); diff != "" {
+		t.Error(diff)
+	}
+}
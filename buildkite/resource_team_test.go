@@ -152,6 +152,13 @@ func TestAccBuildkiteTeam(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the team by its slug
+					ResourceName:      "buildkite_team.acc_tests",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s", "buildkite_team.acc_tests.slug"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -22,6 +22,9 @@ type testSuiteModel struct {
 	TeamOwnerId   types.String `tfsdk:"team_owner_id"`
 	Name          types.String `tfsdk:"name"`
 	Slug          types.String `tfsdk:"slug"`
+
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
 }
 
 type testSuiteResponse struct {
@@ -47,6 +50,7 @@ func (ts *testSuiteResource) Configure(ctx context.Context, req resource.Configu
 	}
 
 	ts.client = req.ProviderData.(*Client)
+	ts.client.warnMissingScopes("buildkite_test_suite", []string{"read_suites", "write_suites"}, &resp.Diagnostics)
 }
 
 func (ts *testSuiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,7 +118,7 @@ func (ts *testSuiteResource) Create(ctx context.Context, req resource.CreateRequ
 	if createErr != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create test suite",
-			fmt.Sprintf("Failed to create test suite: %s", createErr.Error()),
+			fmt.Sprintf("Failed to create test suite: %s", ts.client.describeScopedError(createErr, "write_suites")),
 		)
 		return
 	}
@@ -126,6 +130,8 @@ func (ts *testSuiteResource) Create(ctx context.Context, req resource.CreateRequ
 	state.Name = types.StringValue(response.Name)
 	state.Slug = types.StringValue(response.Slug)
 	state.TeamOwnerId = plan.TeamOwnerId
+	state.DeletionProtection = plan.DeletionProtection
+	state.DeletionPolicy = plan.DeletionPolicy
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -140,6 +146,10 @@ func (ts *testSuiteResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if !checkDeletion("test suite", state.DeletionProtection, state.DeletionPolicy, &resp.Diagnostics) {
+		return
+	}
+
 	timeout, diags := ts.client.timeouts.Delete(ctx, DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
 
@@ -148,12 +158,16 @@ func (ts *testSuiteResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		err := ts.client.makeRequest(ctx, "DELETE", url, nil, nil)
 
+		if err != nil && isNotFoundError(err) {
+			return nil
+		}
+
 		return retryContextError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to delete test suite",
-			fmt.Sprintf("Failed to delete test suite: %s", err.Error()),
+			fmt.Sprintf("Failed to delete test suite: %s", ts.client.describeScopedError(err, "write_suites")),
 		)
 		return
 	}
@@ -191,9 +205,12 @@ func (ts *testSuiteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Test suite", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Failed to load test suite from GraphQL",
-			fmt.Sprintf("Failed to load test suite from GraphQL: %s", err.Error()),
+			fmt.Sprintf("Failed to load test suite from GraphQL: %s", describeError(err)),
 		)
 		return
 	}
@@ -230,6 +247,8 @@ func (ts *testSuiteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	setDeletionDefaults(&state.DeletionProtection, &state.DeletionPolicy)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -279,6 +298,8 @@ func (ts *testSuiteResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "The default branch for the repository this test suite is for.",
 				Required:            true,
 			},
+			"deletion_protection": deletionProtectionAttribute("test suite"),
+			"deletion_policy":     deletionPolicyAttribute("test suite"),
 		},
 	}
 }
@@ -319,13 +340,15 @@ func (ts *testSuiteResource) Update(ctx context.Context, req resource.UpdateRequ
 	if updateErr != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update test suite",
-			fmt.Sprintf("Failed to update test suite: %s", updateErr.Error()),
+			fmt.Sprintf("Failed to update test suite: %s", ts.client.describeScopedError(updateErr, "write_suites")),
 		)
 		return
 	}
 
 	state.Name = plan.Name
 	state.DefaultBranch = plan.DefaultBranch
+	state.DeletionProtection = plan.DeletionProtection
+	state.DeletionPolicy = plan.DeletionPolicy
 	state.Slug = types.StringValue(response.Slug)
 
 	// If the planned team_owner_id differs from the state, add the new one and remove the old one
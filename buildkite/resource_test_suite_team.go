@@ -155,9 +155,12 @@ func (tst *testSuiteTeamResource) Read(ctx context.Context, req resource.ReadReq
 		return retryContextError(err)
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, "Test suite team", resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read test suite team",
-			fmt.Sprintf("Unable to read test suite team member: %s", err.Error()),
+			fmt.Sprintf("Unable to read test suite team member: %s", describeError(err)),
 		)
 		return
 	}
@@ -182,7 +185,66 @@ func (tst *testSuiteTeamResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (tst *testSuiteTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if isGraphQLID(req.ID, "TeamSuite") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	suiteSlug, teamSlug, ok := splitImportID(req.ID)
+	if !ok {
+		addImportIDError(&resp.Diagnostics, req.ID, "id", "suite_slug/team_slug")
+		return
+	}
+
+	// suites can only be found by slug through the REST API
+	tst.client.warnMissingScopes("buildkite_test_suite_team", []string{"read_suites"}, &resp.Diagnostics)
+	log.Printf("Obtaining test suite with slug %s ...", suiteSlug)
+	suite, err := restGet[testSuiteResponse](ctx, tst.client, fmt.Sprintf("/v2/analytics/organizations/%s/suites/%s", tst.client.organization, suiteSlug))
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddError("Unable to import test suite team", fmt.Sprintf("Could not find test suite with slug %q", suiteSlug))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to import test suite team",
+			fmt.Sprintf("Unable to read test suite %s: %s", suiteSlug, tst.client.describeScopedError(err, "read_suites")),
+		)
+		return
+	}
+
+	teamID, err := findTeamID(ctx, tst.client, teamSlug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import test suite team",
+			fmt.Sprintf("Unable to read team %s: %s", teamSlug, describeError(err)),
+		)
+		return
+	}
+
+	r, err := getTestSuite(ctx, tst.client.genqlient, suite.GraphqlID, 100)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import test suite team",
+			fmt.Sprintf("Unable to read teams of test suite %s: %s", suiteSlug, describeError(err)),
+		)
+		return
+	}
+
+	if teamID != "" {
+		if testSuite, ok := r.Suite.(*getTestSuiteSuite); ok {
+			for _, edge := range testSuite.Teams.Edges {
+				if edge.Node.Team.Id == teamID {
+					resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), edge.Node.Id)...)
+					return
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to import test suite team",
+		fmt.Sprintf("Could not find team %q with access to test suite %q", teamSlug, suiteSlug),
+	)
 }
 
 func (tst *testSuiteTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -255,7 +317,7 @@ func (tst *testSuiteTeamResource) Delete(ctx context.Context, req resource.Delet
 			tst.client.genqlient,
 			state.ID.ValueString(),
 		)
-		if err != nil && isResourceNotFoundError(err) {
+		if err != nil && isNotFoundError(err) {
 			return nil
 		}
 
@@ -264,7 +326,7 @@ func (tst *testSuiteTeamResource) Delete(ctx context.Context, req resource.Delet
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete test suite team",
-			fmt.Sprintf("Unable to delete test suite team: %s", err.Error()),
+			fmt.Sprintf("Unable to delete test suite team: %s", describeError(err)),
 		)
 		return
 	}
@@ -185,6 +185,13 @@ func TestAccBuildkiteTestSuiteTeamResource(t *testing.T) {
 					ImportState:       true,
 					ImportStateVerify: true,
 				},
+				{
+					// import the resource by the slugs of the test suite and team
+					ResourceName:      "buildkite_test_suite_team.teamsuite",
+					ImportState:       true,
+					ImportStateIdFunc: testAccImportStateID("%s/%s", "buildkite_test_suite.testsuite.slug", "buildkite_team.newteam.slug"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -0,0 +1,208 @@
+package buildkite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// restPageSize is the number of items requested per page when listing from the REST API
+const restPageSize = 100
+
+// APIError is returned for REST API requests that receive an error response
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// RequestID is the ID Buildkite assigned to the request, which support can use to trace it
+	RequestID string
+	// Message is the message from the response body, or the raw body if it could not be parsed
+	Message string
+	// Errors holds any validation errors listed in the response body
+	Errors []string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("the Buildkite API request failed: %s %s (status: %d)", e.Method, e.URL, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if len(e.Errors) > 0 {
+		msg += " (" + strings.Join(e.Errors, ", ") + ")"
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" [request ID: %s]", e.RequestID)
+	}
+	return msg
+}
+
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+	apiErr := &APIError{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	var parsed struct {
+		Message string            `json:"message"`
+		Errors  []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.Message == "" {
+		apiErr.Message = string(body)
+		return apiErr
+	}
+
+	apiErr.Message = parsed.Message
+	for _, raw := range parsed.Errors {
+		// validation errors are either plain strings or objects describing the field
+		var str string
+		if json.Unmarshal(raw, &str) == nil {
+			apiErr.Errors = append(apiErr.Errors, str)
+		} else {
+			apiErr.Errors = append(apiErr.Errors, string(raw))
+		}
+	}
+	return apiErr
+}
+
+// send performs a REST API request against an absolute URL, decoding a successful response into responseObject.
+// The response headers are returned so callers can follow pagination links.
+func (client *Client) send(ctx context.Context, method, url string, postData, responseObject any) (http.Header, error) {
+	bodyBytes := io.Reader(nil)
+	if postData != nil {
+		jsonPayload, err := json.Marshal(postData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		bodyBytes = bytes.NewBuffer(jsonPayload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if bodyBytes != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return resp.Header, newAPIError(req, resp)
+	}
+
+	if resp.StatusCode == http.StatusNoContent || responseObject == nil {
+		return resp.Header, nil
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.Header, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if err := json.Unmarshal(responseBody, responseObject); err != nil {
+		return resp.Header, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return resp.Header, nil
+}
+
+// restGet fetches a single object from the REST API
+func restGet[T any](ctx context.Context, client *Client, path string) (*T, error) {
+	var result T
+	if err := client.makeRequest(ctx, http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// restSend sends body to the REST API with the given method and decodes the object returned
+func restSend[T any](ctx context.Context, client *Client, method, path string, body any) (*T, error) {
+	var result T
+	if err := client.makeRequest(ctx, method, path, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// restDelete deletes an object through the REST API
+func restDelete(ctx context.Context, client *Client, path string) error {
+	return client.makeRequest(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// restList fetches every item of a REST API collection, following the Link header through all of its pages
+func restList[T any](ctx context.Context, client *Client, path string) ([]T, error) {
+	ctx, cancel := client.withReadTimeout(ctx)
+	defer cancel()
+
+	next, err := url.Parse(client.restURL + path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	query := next.Query()
+	if query.Get("per_page") == "" {
+		query.Set("per_page", fmt.Sprint(restPageSize))
+		next.RawQuery = query.Encode()
+	}
+
+	items := make([]T, 0)
+	// the pages already fetched, so a Link header that points back at one of them can't loop forever
+	visited := make(map[string]bool)
+	for next != nil {
+		if visited[next.String()] {
+			return nil, fmt.Errorf("pagination of %s returned the page %s again", path, next)
+		}
+		visited[next.String()] = true
+
+		var page []T
+		header, err := client.send(ctx, http.MethodGet, next.String(), nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+
+		next = nextPageURL(next, header)
+	}
+
+	return items, nil
+}
+
+// nextPageURL returns the URL of the next page from a Link header, resolved against the URL of the current page, or
+// nil on the last page.
+func nextPageURL(current *url.URL, header http.Header) *url.URL {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		target, params, found := strings.Cut(strings.TrimSpace(link), ";")
+		if !found || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+
+		for _, param := range strings.Split(params, ";") {
+			if strings.ReplaceAll(strings.TrimSpace(param), `"`, "") != "rel=next" {
+				continue
+			}
+			next, err := current.Parse(strings.Trim(target, "<>"))
+			if err != nil {
+				return nil
+			}
+			return next
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRestList(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		switch page {
+		case 0:
+			// absolute link, as returned by the API
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/v2/items?page=2&per_page=2>; rel="next", <http://%s/v2/items?page=3&per_page=2>; rel="last"`, r.Host, r.Host))
+			fmt.Fprint(w, `[{"id": "a"}, {"id": "b"}]`)
+		case 2:
+			w.Header().Set("Link", `</v2/items?page=3&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id": "c"}, {"id": "d"}]`)
+		default:
+			fmt.Fprint(w, `[{"id": "e"}]`)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{http: server.Client(), restURL: server.URL}
+
+	items, err := restList[struct {
+		ID string `json:"id"`
+	}](context.Background(), client, "/v2/items")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	if diff := cmp.Diff([]string{"a", "b", "c", "d", "e"}, ids); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"/v2/items?per_page=100", "/v2/items?page=2&per_page=2", "/v2/items?page=3&per_page=2"}, requests); diff != "" {
+		t.Errorf("unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestRestListStopsOnRepeatedPage(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// the second page links back to the first
+		if r.URL.Query().Get("page") == "2" {
+			w.Header().Set("Link", `</v2/items?page=1&per_page=100>; rel="next"`)
+		} else {
+			w.Header().Set("Link", `</v2/items?page=2&per_page=100>; rel="next"`)
+		}
+		fmt.Fprint(w, `[{"id": "a"}]`)
+	}))
+	defer server.Close()
+
+	client := &Client{http: server.Client(), restURL: server.URL}
+
+	_, err := restList[struct {
+		ID string `json:"id"`
+	}](context.Background(), client, "/v2/items?page=1")
+	if err == nil {
+		t.Fatal("expected an error for a repeated page")
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	testCases := map[string]struct {
+		status   int
+		body     string
+		expected APIError
+		notFound bool
+	}{
+		"not found": {
+			status:   http.StatusNotFound,
+			body:     `{"message": "Not Found"}`,
+			expected: APIError{StatusCode: http.StatusNotFound, Message: "Not Found"},
+			notFound: true,
+		},
+		"validation errors": {
+			status:   http.StatusUnprocessableEntity,
+			body:     `{"message": "Validation Failed", "errors": ["Name has already been taken", {"field": "ecosystem"}]}`,
+			expected: APIError{StatusCode: http.StatusUnprocessableEntity, Message: "Validation Failed", Errors: []string{"Name has already been taken", `{"field": "ecosystem"}`}},
+		},
+		"unstructured body": {
+			status:   http.StatusForbidden,
+			body:     "Forbidden",
+			expected: APIError{StatusCode: http.StatusForbidden, Message: "Forbidden"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "request-1234")
+				w.WriteHeader(testCase.status)
+				fmt.Fprint(w, testCase.body)
+			}))
+			defer server.Close()
+
+			client := &Client{http: server.Client(), restURL: server.URL}
+
+			_, err := restGet[map[string]any](context.Background(), client, "/v2/thing")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got %v", err)
+			}
+
+			testCase.expected.Method = http.MethodGet
+			testCase.expected.URL = server.URL + "/v2/thing"
+			testCase.expected.RequestID = "request-1234"
+			if diff := cmp.Diff(testCase.expected, *apiErr); diff != "" {
+				t.Errorf("unexpected error (-want +got):\n%s", diff)
+			}
+
+			if isNotFoundError(err) != testCase.notFound {
+				t.Errorf("expected isNotFoundError to be %v", testCase.notFound)
+			}
+		})
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	current, _ := url.Parse("https://api.buildkite.com/v2/items?page=1")
+
+	testCases := map[string]struct {
+		link     string
+		expected string
+	}{
+		"no header":     {link: "", expected: ""},
+		"last page":     {link: `<https://api.buildkite.com/v2/items?page=1>; rel="first", <https://api.buildkite.com/v2/items?page=1>; rel="prev"`, expected: ""},
+		"next page":     {link: `<https://api.buildkite.com/v2/items?page=2>; rel="next"`, expected: "https://api.buildkite.com/v2/items?page=2"},
+		"relative link": {link: `</v2/items?page=2>; rel=next`, expected: "https://api.buildkite.com/v2/items?page=2"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if testCase.link != "" {
+				header.Set("Link", testCase.link)
+			}
+
+			next := nextPageURL(current, header)
+			got := ""
+			if next != nil {
+				got = next.String()
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -8,42 +8,23 @@ import (
 	"regexp"
 	"strings"
 
+	genqlient "github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/shurcooL/graphql"
 )
 
-var resourceNotFoundRegex = regexp.MustCompile(`(?i)(No\s+\w+(\s+\w+)*\s+found|not\s+found|no\s+longer\s+exists)`)
-
-// isResourceNotFoundError returns true if the error indicates the resource was not found
-func isResourceNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	return resourceNotFoundRegex.MatchString(err.Error())
-}
-
 // GetOrganizationID retrieves the Buildkite organization ID associated with the supplied slug
-func GetOrganizationID(slug string, client *graphql.Client) (string, error) {
-	var query struct {
-		Organization struct {
-			ID graphql.ID
-		} `graphql:"organization(slug: $slug)"`
-	}
-	vars := map[string]interface{}{
-		"slug": slug,
-	}
-	err := client.Query(context.Background(), &query, vars)
+func GetOrganizationID(slug string, client genqlient.Client) (string, error) {
+	r, err := getOrganization(context.Background(), client, slug)
 	if err != nil {
 		return "", err
 	}
 
-	id, ok := query.Organization.ID.(string)
-	if !ok {
+	if r.Organization.Id == "" {
 		return "", fmt.Errorf("organization %s not found", slug)
 	}
 
-	return id, nil
+	return r.Organization.Id, nil
 }
 
 // GetTeamID retrieves the Buildkite team ID associated with the supplied team slug
@@ -53,17 +34,11 @@ func GetTeamID(slug string, client *Client) (string, error) {
 	if !strings.HasPrefix(slug, prefix) {
 		slug = prefix + slug
 	}
-	var query struct {
-		Team TeamNode `graphql:"team(slug: $slug)"`
-	}
-	params := map[string]interface{}{
-		"slug": graphql.ID(slug),
-	}
-	err := client.graphql.Query(context.Background(), &query, params)
+	r, err := GetTeamFromSlug(context.Background(), client.genqlient, slug)
 	if err != nil {
 		return "", err
 	}
-	id := string(query.Team.ID)
+	id := r.Team.Id
 	log.Printf("Found id '%s' for team '%s'.", id, slug)
 	return id, nil
 }
@@ -91,12 +66,21 @@ func createCidrSliceFromList(cidrList types.List) []string {
 }
 
 // retryContextError wraps an error for use with hashicorp/terraform-plugin-sdk/v2/helper/retry.
-// Since the underlying http client now handles retries, we always treat errors as non-retryable
-// at this layer to avoid duplicate retries.
+// Rate limited and server errors are retried, as GraphQL requests are not retried by the http client.
 func retryContextError(err error) *retry.RetryError {
-	if err != nil {
-		// Always return NonRetryableError as the http client handles retries
-		return retry.NonRetryableError(err)
+	if err == nil {
+		return nil
+	}
+	if isRetryableError(err) {
+		return retry.RetryableError(err)
+	}
+	return retry.NonRetryableError(err)
+}
+
+// stringValueOrNull returns a null string for an empty value, for optional attributes the API leaves blank
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
 	}
-	return nil
+	return types.StringValue(s)
 }
@@ -2,6 +2,7 @@ package buildkite
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -16,7 +17,10 @@ func TestGetOrganizationIDMissing(t *testing.T) {
 		userAgent:  "test-user-agent",
 	}
 
-	client := NewClient(config)
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
 	org, err := client.GetOrganizationID()
 	if err == nil {
 		t.Fatal("No error occurred")
@@ -25,3 +29,17 @@ func TestGetOrganizationIDMissing(t *testing.T) {
 		t.Fatalf("Nonexistent organization found")
 	}
 }
+
+func TestGetOrganizationIDFake(t *testing.T) {
+	server, client := fakeTestClient(t)
+
+	id, err := GetOrganizationID(server.Organization, client.genqlient)
+	if err != nil || id == "" {
+		t.Fatalf("expected the organization ID, got %q and %v", id, err)
+	}
+
+	_, err = GetOrganizationID("another-org", client.genqlient)
+	if err == nil || !strings.Contains(err.Error(), "another-org not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+data "buildkite_hosted_agent_catalog" "catalog" {}
+
+# use the first Linux ARM64 shape with at least 4 vCPUs
+locals {
+  linux_arm64_shapes = [
+    for shape in data.buildkite_hosted_agent_catalog.catalog.instance_shapes : shape.name
+    if shape.machine_type == "LINUX" && shape.architecture == "ARM64" && shape.vcpu >= 4
+  ]
+}
+
+resource "buildkite_cluster_queue" "arm" {
+  cluster_id = buildkite_cluster.primary.id
+  key        = "arm"
+
+  hosted_agents = {
+    instance_shape = local.linux_arm64_shapes[0]
+    linux = {
+      agent_image_ref = "buildkite/agent:latest"
+    }
+  }
+}
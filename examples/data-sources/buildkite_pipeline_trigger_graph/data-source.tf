@@ -0,0 +1,13 @@
+data "buildkite_pipeline_trigger_graph" "graph" {
+  pipelines = ["app", "release"]
+}
+
+# allow every pipeline triggered by app or release to be triggered by it
+resource "buildkite_organization_rule" "triggers" {
+  for_each = data.buildkite_pipeline_trigger_graph.graph.edges
+
+  pipeline_trigger_build = {
+    source_pipeline_id = each.value.source_pipeline_id
+    target_pipeline_id = each.value.target_pipeline_id
+  }
+}
@@ -42,3 +42,33 @@ resource "buildkite_pipeline" "signed-pipeline" {
   repository = "git@github.com:my-org/my-repo.git"
   steps      = data.buildkite_signed_pipeline_steps.signed-steps.steps
 }
+
+# Substituting known values and leaving runtime variables for the job
+data "buildkite_signed_pipeline_steps" "deploy-steps" {
+  repository    = "git@github.com:my-org/my-repo.git"
+  jwks_file     = "/path/to/my/jwks-private.json"
+  interpolation = "preserve_runtime"
+  env = {
+    IMAGE = "my-app:${var.app_version}"
+  }
+
+  unsigned_steps = <<YAML
+steps:
+- label: ":rocket:"
+  command: deploy --image $IMAGE --branch $BUILDKITE_BRANCH
+YAML
+}
+
+# Encrypted PEM private key, with the password and key ID from variables
+data "buildkite_signed_pipeline_steps" "pem-signed-steps" {
+  repository           = "git@github.com:my-org/my-repo.git"
+  private_key_file     = "/path/to/my/signing-key.pem"
+  private_key_password = var.signing_key_password
+  jwks_key_id          = "my-key-id"
+
+  unsigned_steps = <<YAML
+steps:
+- label: ":pipeline:"
+  command: buildkite-agent pipeline upload
+YAML
+}
@@ -0,0 +1,5 @@
+data "buildkite_token" "current" {}
+
+output "token_scopes" {
+  value = data.buildkite_token.current.scopes
+}
@@ -0,0 +1,12 @@
+data "buildkite_verified_pipeline_steps" "deployed" {
+  repository = buildkite_pipeline.signed-pipeline.repository
+  steps      = buildkite_pipeline.signed-pipeline.steps
+  jwks_file  = "/path/to/my/jwks-public.json"
+
+  lifecycle {
+    postcondition {
+      condition     = self.verified
+      error_message = "Steps failed verification: ${jsonencode([for r in self.results : r if !r.verified])}"
+    }
+  }
+}
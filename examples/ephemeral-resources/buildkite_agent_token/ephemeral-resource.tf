@@ -0,0 +1,17 @@
+# keep only the UUID of the token in state
+resource "buildkite_agent_token" "default" {
+  description = "Default token"
+  store_token = false
+}
+
+# read the token value and write it to AWS SSM without it being stored in state
+ephemeral "buildkite_agent_token" "default" {
+  uuid = buildkite_agent_token.default.uuid
+}
+
+resource "aws_ssm_parameter" "agent_token" {
+  name             = "/buildkite/agent-token"
+  type             = "SecureString"
+  value_wo         = ephemeral.buildkite_agent_token.default.token
+  value_wo_version = 1
+}
@@ -0,0 +1,23 @@
+resource "buildkite_cluster" "primary" {
+  name = "Primary cluster"
+}
+
+# keep only the UUID of the token in state
+resource "buildkite_cluster_agent_token" "default" {
+  description = "Default cluster token"
+  cluster_id  = buildkite_cluster.primary.id
+  store_token = false
+}
+
+# read the token value and write it to Vault without it being stored in state
+ephemeral "buildkite_cluster_agent_token" "default" {
+  cluster_id = buildkite_cluster.primary.id
+  uuid       = buildkite_cluster_agent_token.default.uuid
+}
+
+resource "vault_kv_secret_v2" "agent_token" {
+  mount                = "secret"
+  name                 = "buildkite/agent-token"
+  data_json_wo         = jsonencode({ token = ephemeral.buildkite_cluster_agent_token.default.token })
+  data_json_wo_version = 1
+}
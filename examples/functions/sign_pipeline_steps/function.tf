@@ -0,0 +1,14 @@
+locals {
+  repository = "git@github.com:my-org/my-repo.git"
+}
+
+resource "buildkite_pipeline" "signed-pipeline" {
+  name       = "my-signed-pipeline"
+  repository = local.repository
+  steps = provider::buildkite::sign_pipeline_steps(
+    file("${path.module}/pipeline.yml"),
+    local.repository,
+    file("/path/to/my/jwks-private.json"),
+    "my-key-id",
+  )
+}
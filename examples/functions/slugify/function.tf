@@ -0,0 +1,13 @@
+locals {
+  pipeline_name = "My Pipeline"
+}
+
+resource "buildkite_pipeline" "pipeline" {
+  name       = local.pipeline_name
+  repository = "git@github.com:my-org/my-repo.git"
+}
+
+# the slug is known before the pipeline is created
+output "pipeline_url" {
+  value = format("https://buildkite.com/my-org/%s", provider::buildkite::slugify(local.pipeline_name))
+}
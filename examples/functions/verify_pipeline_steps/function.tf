@@ -0,0 +1,10 @@
+check "pipeline_signatures" {
+  assert {
+    condition = provider::buildkite::verify_pipeline_steps(
+      buildkite_pipeline.signed-pipeline.steps,
+      buildkite_pipeline.signed-pipeline.repository,
+      file("/path/to/my/jwks-public.json"),
+    ).verified
+    error_message = "The steps of my-signed-pipeline are not signed by a current key"
+  }
+}
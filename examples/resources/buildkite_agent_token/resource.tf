@@ -2,3 +2,9 @@
 resource "buildkite_agent_token" "default" {
   description = "Default token"
 }
+
+# keep only the UUID of the token in state, reading the value with the ephemeral resource when it's needed
+resource "buildkite_agent_token" "uuid_only" {
+  description = "Token kept out of state"
+  store_token = false
+}
@@ -1,4 +1,7 @@
-# import a cluster resource using the GraphQL ID
+# import a cluster resource using its name
+terraform import buildkite_cluster.primary "Primary cluster"
+
+# or using the GraphQL ID
 #
 # you can use this query to find the ID:
 # query getClusters {
@@ -4,6 +4,9 @@ resource "buildkite_cluster" "primary" {
   description = "Runs the monolith build and deploy"
   emoji       = "🚀"
   color       = "#bada55"
+
+  # set to false and apply before removing the cluster
+  deletion_protection = true
 }
 
 # add a pipeline to the cluster
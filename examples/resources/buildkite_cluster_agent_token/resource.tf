@@ -18,6 +18,13 @@ resource "buildkite_cluster_agent_token" "ip_limited_token" {
   allowed_ip_addresses = ["10.100.1.0/28"]
 }
 
+# keep only the UUID of the token in state, reading the value with the ephemeral resource when it's needed
+resource "buildkite_cluster_agent_token" "uuid_only" {
+  description = "Token kept out of state"
+  cluster_id  = buildkite_cluster.primary.id
+  store_token = false
+}
+
 resource "buildkite_pipeline" "monolith" {
   name       = "Monolith"
   repository = "https://github.com/..."
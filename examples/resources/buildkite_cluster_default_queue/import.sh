@@ -1,4 +1,7 @@
-# import a clusters default queue resource using the GraphQL ID of the cluster itself
+# import a clusters default queue resource using the name of the cluster
+terraform import buildkite_cluster_default_queue.primary "Primary cluster"
+
+# or using the GraphQL ID of the cluster itself
 #
 # you can use this query to find the ID:
 # query getClusters {
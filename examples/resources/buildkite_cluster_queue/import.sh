@@ -1,4 +1,7 @@
-# import a cluster queue resource using the GraphQL ID along with its respective cluster UUID
+# import a cluster queue resource using the name of its cluster and its key
+terraform import buildkite_cluster_queue.test "Primary cluster/default"
+
+# or using the GraphQL ID along with its respective cluster UUID
 #
 # you can use this query to find the ID:
 # query getClusterQueues {
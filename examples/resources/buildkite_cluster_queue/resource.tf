@@ -16,8 +16,9 @@ resource "buildkite_pipeline" "monolith" {
 resource "buildkite_cluster_queue" "default" {
   cluster_id = buildkite_cluster.primary.id
   key        = "default"
-  # Pause dispatch after create
-  dispatch_paused = true
+  # Pause dispatch after create, explaining why in Buildkite
+  dispatch_paused      = true
+  dispatch_paused_note = "Waiting for the new agent fleet"
 }
 
 # create a hosted agent queue with macos agents
@@ -49,3 +50,14 @@ resource "buildkite_cluster_queue" "hosted_agents_linux" {
     }
   }
 }
+
+# wait for running jobs to finish and stop the agents before the queue is deleted
+resource "buildkite_cluster_queue" "legacy" {
+  cluster_id = buildkite_cluster.primary.id
+  key        = "legacy"
+
+  drain_on_destroy = {
+    note        = "Retiring the legacy queue"
+    stop_agents = true
+  }
+}
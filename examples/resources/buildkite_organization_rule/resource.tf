@@ -29,4 +29,16 @@ resource "buildkite_organization_rule" "trigger_build_test_dev_cond" {
       "source.build.branch == 'main'"
     ]
   })
-}
\ No newline at end of file
+}
+
+# Creates a TRIGGER_BUILD organization rule with conditions, without writing the JSON document
+resource "buildkite_organization_rule" "trigger_build_typed" {
+  description = "A rule to allow app_dev_deploy to trigger app_test_ci builds from main"
+  pipeline_trigger_build = {
+    source_pipeline_id = buildkite_pipeline.app_dev_deploy.id
+    target_pipeline_id = buildkite_pipeline.app_test_ci.id
+    conditions = [
+      "source.build.branch == 'main'"
+    ]
+  }
+}
@@ -1,3 +1,6 @@
-# import a pipeline resource using the pipelines GraphQL ID
+# import a pipeline resource using the pipelines slug
+terraform import buildkite_pipeline.pipeline my-pipeline
+
+# or using the pipelines GraphQL ID
 # GraphQL ID for a pipeline can be found on its settings page
 terraform import buildkite_pipeline.pipeline UGlwZWxpbmUtLS00MzVjYWQ1OC1lODFkLTQ1YWYtODYzNy1iMWNmODA3MDIzOGQ=
@@ -27,6 +27,28 @@ resource "buildkite_pipeline" "pipeline" {
   }
 }
 
+# with authoritative team access
+data "buildkite_cluster" "default" {
+  name = "Default cluster"
+}
+data "buildkite_team" "platform" {
+  slug = "platform"
+}
+data "buildkite_team" "everyone" {
+  slug = "everyone"
+}
+resource "buildkite_pipeline" "pipeline" {
+  name       = "repo"
+  repository = "git@github.com:my-org/my-repo"
+  cluster_id = data.buildkite_cluster.default.id
+
+  # any other team with access to the pipeline has it removed
+  teams = [
+    { team_id = data.buildkite_team.platform.id, access_level = "MANAGE_BUILD_AND_READ" },
+    { team_id = data.buildkite_team.everyone.id, access_level = "READ_ONLY" },
+  ]
+}
+
 # signed pipeline
 data "buildkite_cluster" "default" {
   name = "Default cluster"
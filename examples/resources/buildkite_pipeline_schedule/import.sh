@@ -1,4 +1,7 @@
-# import a pipeline schedule resource using the schedules GraphQL ID
+# import a pipeline schedule resource using the slug of its pipeline and its label
+terraform import buildkite_pipeline_schedule.test "my-pipeline/Nightly build"
+
+# or using the schedules GraphQL ID
 #
 # you can use this query to find the schedule:
 # query getPipelineScheduleId {
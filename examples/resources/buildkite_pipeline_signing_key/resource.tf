@@ -0,0 +1,29 @@
+resource "buildkite_pipeline_signing_key" "pipelines" {
+  algorithm = "EdDSA"
+
+  # change the value to rotate the key
+  keepers = {
+    rotation = "2024-06"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+data "buildkite_signed_pipeline_steps" "signed-steps" {
+  repository = "git@github.com:my-org/my-repo.git"
+  jwks       = buildkite_pipeline_signing_key.pipelines.private_jwks
+
+  unsigned_steps = <<YAML
+steps:
+- label: ":pipeline:"
+  command: buildkite-agent pipeline upload
+YAML
+}
+
+# make the public key available to the agents, for example in a secret they load at startup
+resource "aws_secretsmanager_secret_version" "agent-verification-jwks" {
+  secret_id     = "buildkite/agent/verification-jwks"
+  secret_string = buildkite_pipeline_signing_key.pipelines.public_jwks
+}
@@ -1,4 +1,7 @@
-# import a pipeline team resource using the GraphQL ID
+# import a pipeline team resource using the slugs of the pipeline and the team
+terraform import buildkite_pipeline_team.guests my-pipeline/guests
+
+# or using the GraphQL ID
 #
 # you can use this query to find the ID:
 # query getPipelineTeamId {
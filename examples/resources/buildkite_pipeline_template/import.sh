@@ -1,4 +1,7 @@
-# import a pipeline template resource using the templates GraphQL ID
+# import a pipeline template resource using the templates name
+terraform import buildkite_pipeline_template.template "Template foo"
+
+# or using the templates GraphQL ID
 #
 # You can use this query to find the first 50 templates (adjust for less or more):
 # query getPipelineTemplateIds {
@@ -1,7 +1,14 @@
 resource "buildkite_registry" "example" {
-  name = "example"
+  name        = "example"
   description = "super cool ruby registry"
-  ecosystem = "ruby"
-  emoji = ":ruby:"
-  color = "#ff0000"
+  ecosystem   = "ruby"
+  emoji       = ":ruby:"
+  color       = "#ff0000"
+}
+
+# keep the registry and its packages in Buildkite if it is removed from Terraform
+resource "buildkite_registry" "retained" {
+  name            = "retained"
+  ecosystem       = "ruby"
+  deletion_policy = "RETAIN"
 }
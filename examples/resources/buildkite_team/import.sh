@@ -1,4 +1,7 @@
-# import a team resource using the GraphQL ID
+# import a team resource using its slug
+terraform import buildkite_team.everyone everyone
+
+# or using the GraphQL ID
 #
 # you can use this query to find the ID:
 # query getTeamId {
@@ -1,4 +1,7 @@
-# import a team member resource using the GraphQL ID
+# import a team member resource using the slug of the team and the email of the user
+terraform import buildkite_team_member.a_smith everyone/a.smith@example.com
+
+# or using the GraphQL ID
 #
 # you can use this query to find the ID:
 # query getTeamMemberId {
@@ -0,0 +1,8 @@
+# import the members of a team using the slug of the team
+terraform import buildkite_team_members.everyone everyone
+
+# or using the GraphQL ID of the team
+terraform import buildkite_team_members.everyone VGVhbS0tLTg1ZDNjMjc2LTJiNWMtNGE5NS1hNDA3LTc3YmFjODQ1OTAwMA==
+
+# imported members are identified by user_id, add /email to identify them by email instead
+terraform import buildkite_team_members.everyone everyone/email
@@ -0,0 +1,4 @@
+import {
+  to = buildkite_team_members.everyone
+  id = "everyone"
+}
@@ -0,0 +1,20 @@
+resource "buildkite_team" "everyone" {
+  name                = "Everyone"
+  privacy             = "VISIBLE"
+  default_team        = false
+  default_member_role = "MEMBER"
+}
+
+# manage every member of the team, removing anyone added outside of Terraform
+resource "buildkite_team_members" "everyone" {
+  team_id = buildkite_team.everyone.id
+
+  members = [
+    { email = "a.smith@example.com", role = "MAINTAINER" },
+    { email = "b.jones@example.com", role = "MEMBER" },
+    { user_id = "VXNlci0tLThkYjI5MjBlLTNjNjAtNDhhNy1hM2Y4LTI1ODRiZTM3NGJhYw==", role = "MEMBER" },
+  ]
+
+  # leave maintainers added in the Buildkite UI in place
+  ignore_maintainers = true
+}
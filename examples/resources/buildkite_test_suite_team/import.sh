@@ -1,4 +1,7 @@
-# import a test suite team resource using the GraphQL ID
+# import a test suite team resource using the slugs of the test suite and the team
+terraform import buildkite_test_suite_team.main_everyone acceptance/everyone
+
+# or using the GraphQL ID
 #
 # you can use this query to find the ID:
 # query getTeamSuiteIds {
@@ -0,0 +1,126 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ basetypes.StringTypable                    = YAMLType{}
+	_ basetypes.StringValuableWithSemanticEquals = YAMLValue{}
+)
+
+// YAMLType is a string type holding a YAML document. Values are semantically equal when their parsed documents are,
+// so key order, quoting and whitespace differences do not cause a diff.
+type YAMLType struct {
+	basetypes.StringType
+}
+
+func (t YAMLType) String() string {
+	return "customtypes.YAMLType"
+}
+
+func (t YAMLType) ValueType(ctx context.Context) attr.Value {
+	return YAMLValue{}
+}
+
+func (t YAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(YAMLType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t YAMLType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YAMLValue{StringValue: in}, nil
+}
+
+func (t YAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// YAMLValue is a string value holding a YAML document
+type YAMLValue struct {
+	basetypes.StringValue
+}
+
+func NewYAMLValue(value string) YAMLValue {
+	return YAMLValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewYAMLNull() YAMLValue {
+	return YAMLValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewYAMLUnknown() YAMLValue {
+	return YAMLValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v YAMLValue) Type(ctx context.Context) attr.Type {
+	return YAMLType{}
+}
+
+func (v YAMLValue) Equal(o attr.Value) bool {
+	other, ok := o.(YAMLValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals compares the parsed YAML documents. Documents that fail to parse are only equal when their
+// text is identical, as the validation of the attribute will report the problem.
+func (v YAMLValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YAMLValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+
+	var oldDocument, newDocument any
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &oldDocument); err != nil {
+		return false, diags
+	}
+	if err := yaml.Unmarshal([]byte(newValue.ValueString()), &newDocument); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument), diags
+}
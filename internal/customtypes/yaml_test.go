@@ -0,0 +1,70 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestYAMLValueStringSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"identical": {
+			old:      "steps:\n  - command: make test\n",
+			new:      "steps:\n  - command: make test\n",
+			expected: true,
+		},
+		"key order and whitespace": {
+			old:      "steps:\n  - label: test\n    command: make test\n",
+			new:      "steps:\n- command: \"make test\"\n  label: 'test'\n\n",
+			expected: true,
+		},
+		"flow style": {
+			old:      "steps:\n  - command: make test\n    agents:\n      queue: default\n",
+			new:      `{"steps": [{"agents": {"queue": "default"}, "command": "make test"}]}`,
+			expected: true,
+		},
+		"different value": {
+			old:      "steps:\n  - command: make test\n",
+			new:      "steps:\n  - command: make lint\n",
+			expected: false,
+		},
+		"step order": {
+			old:      "steps:\n  - command: a\n  - command: b\n",
+			new:      "steps:\n  - command: b\n  - command: a\n",
+			expected: false,
+		},
+		"scalar types": {
+			old:      "steps:\n  - command: make test\n    parallelism: 2\n",
+			new:      "steps:\n  - command: make test\n    parallelism: \"2\"\n",
+			expected: false,
+		},
+		"invalid YAML": {
+			old:      "steps:\n  - command: [make\n",
+			new:      "steps:\n  - command: [make \n",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := NewYAMLValue(testCase.old).StringSemanticEquals(context.Background(), NewYAMLValue(testCase.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if equal != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, equal)
+			}
+		})
+	}
+
+	t.Run("other value types", func(t *testing.T) {
+		_, diags := NewYAMLValue("steps: []").StringSemanticEquals(context.Background(), types.StringValue("steps: []"))
+		if !diags.HasError() {
+			t.Error("expected an error comparing with a plain string value")
+		}
+	})
+}
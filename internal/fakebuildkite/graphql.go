@@ -0,0 +1,261 @@
+package fakebuildkite
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// object is a GraphQL object held by the fake. Every object carries its __typename so fragments can be matched
+// against it.
+type object map[string]any
+
+// resolver computes the value of a field that is not stored directly on an object, or that takes arguments.
+type resolver func(s *Server, parent object, args map[string]any) (any, error)
+
+// gqlError is a single entry of the errors list in a GraphQL response.
+type gqlError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// argumentError is returned by resolvers for arguments that fail schema validation. The API reports these before
+// executing the operation, so they have no path.
+type argumentError string
+
+func (e argumentError) Error() string {
+	return string(e)
+}
+
+type gqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]any{"message": "GraphQL requests must be made with POST"})
+		return
+	}
+
+	var req gqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []gqlError{{Message: err.Error()}}})
+		return
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		writeJSON(w, http.StatusOK, map[string]any{"errors": []gqlError{{Message: err.Error()}}})
+		return
+	}
+
+	op := doc.Operations.ForName(req.OperationName)
+	if op == nil {
+		writeJSON(w, http.StatusOK, map[string]any{"errors": []gqlError{{Message: "No operation named " + strconv.Quote(req.OperationName)}}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &executor{server: s, doc: doc, vars: req.Variables}
+	root := object{"__typename": "Query"}
+	if op.Operation == ast.Mutation {
+		root = object{"__typename": "Mutation"}
+	}
+	data := e.selectionSet(op.SelectionSet, root, nil)
+
+	resp := map[string]any{"data": data}
+	if len(e.errors) > 0 {
+		resp["errors"] = e.errors
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// executor evaluates a single GraphQL operation against the server state. It does not validate the operation
+// against the schema; unknown fields simply resolve to null.
+type executor struct {
+	server *Server
+	doc    *ast.QueryDocument
+	vars   map[string]any
+	errors []gqlError
+}
+
+func (e *executor) selectionSet(set ast.SelectionSet, obj object, path []any) map[string]any {
+	result := make(map[string]any)
+	e.collect(set, obj, path, result)
+	return result
+}
+
+func (e *executor) collect(set ast.SelectionSet, obj object, path []any, result map[string]any) {
+	typename, _ := obj["__typename"].(string)
+
+	for _, selection := range set {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			key := sel.Alias
+			if key == "" {
+				key = sel.Name
+			}
+			fieldPath := append(append([]any(nil), path...), key)
+
+			value, err := e.field(sel, obj, typename)
+			if err != nil {
+				gqlErr := gqlError{Message: err.Error(), Path: fieldPath}
+				if _, ok := err.(argumentError); ok {
+					gqlErr.Path = nil
+				}
+				e.errors = append(e.errors, gqlErr)
+				result[key] = nil
+				continue
+			}
+			result[key] = e.complete(sel.SelectionSet, value, fieldPath)
+		case *ast.InlineFragment:
+			if sel.TypeCondition == "" || sel.TypeCondition == typename {
+				e.collect(sel.SelectionSet, obj, path, result)
+			}
+		case *ast.FragmentSpread:
+			fragment := e.doc.Fragments.ForName(sel.Name)
+			if fragment != nil && fragment.TypeCondition == typename {
+				e.collect(fragment.SelectionSet, obj, path, result)
+			}
+		}
+	}
+}
+
+func (e *executor) field(f *ast.Field, obj object, typename string) (any, error) {
+	if f.Name == "__typename" {
+		return typename, nil
+	}
+
+	if resolve, ok := resolvers[typename+"."+f.Name]; ok {
+		args := make(map[string]any)
+		for _, arg := range f.Arguments {
+			if v, ok := e.value(arg.Value); ok {
+				args[arg.Name] = v
+			}
+		}
+		return resolve(e.server, obj, args)
+	}
+
+	return obj[f.Name], nil
+}
+
+func (e *executor) complete(set ast.SelectionSet, value any, path []any) any {
+	switch v := value.(type) {
+	case object:
+		if v == nil {
+			return nil
+		}
+		return e.selectionSet(set, v, path)
+	case []object:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = e.complete(set, item, append(append([]any(nil), path...), i))
+		}
+		return list
+	default:
+		return v
+	}
+}
+
+// value resolves an argument value. Input object fields bound to variables that were not supplied are left out
+// entirely so resolvers can tell an omitted field from an explicit null.
+func (e *executor) value(v *ast.Value) (any, bool) {
+	switch v.Kind {
+	case ast.Variable:
+		val, ok := e.vars[v.Raw]
+		return val, ok
+	case ast.ObjectValue:
+		m := make(map[string]any)
+		for _, child := range v.Children {
+			if val, ok := e.value(child.Value); ok {
+				m[child.Name] = val
+			}
+		}
+		return m, true
+	case ast.ListValue:
+		var list []any
+		for _, child := range v.Children {
+			val, _ := e.value(child.Value)
+			list = append(list, val)
+		}
+		return list, true
+	default:
+		val, err := v.Value(nil)
+		return val, err == nil
+	}
+}
+
+// connection builds a Relay style connection over nodes of the given type, honouring the first and after arguments.
+// Cursors are the position of an edge in the full list.
+func connection(typename string, nodes []object, args map[string]any) object {
+	start := 0
+	if after, ok := args["after"].(string); ok && after != "" {
+		if i, err := strconv.Atoi(after); err == nil {
+			start = i + 1
+		}
+	}
+	start = min(start, len(nodes))
+
+	end := len(nodes)
+	if first, ok := number(args["first"]); ok {
+		end = min(start+first, end)
+	}
+
+	edges := make([]object, 0, end-start)
+	for i := start; i < end; i++ {
+		edges = append(edges, object{
+			"__typename": typename + "Edge",
+			"cursor":     strconv.Itoa(i),
+			"node":       nodes[i],
+		})
+	}
+
+	var endCursor any
+	if len(edges) > 0 {
+		endCursor = edges[len(edges)-1]["cursor"]
+	}
+
+	return object{
+		"__typename": typename + "Connection",
+		"count":      len(nodes),
+		"edges":      edges,
+		"nodes":      nodes[start:end],
+		"pageInfo": object{
+			"__typename":      "PageInfo",
+			"endCursor":       endCursor,
+			"hasNextPage":     end < len(nodes),
+			"hasPreviousPage": start > 0,
+		},
+	}
+}
+
+// number converts a numeric argument, which arrives as a float64 when sent as a variable, to an int.
+func number(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	}
+	return 0, false
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func errorf(format string, args ...any) error {
+	return fmt.Errorf(format, args...)
+}
@@ -0,0 +1,1484 @@
+package fakebuildkite
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
+)
+
+// resolvers are keyed by "Type.field". Fields without a resolver are read straight from the stored object.
+var resolvers map[string]resolver
+
+func init() {
+	resolvers = map[string]resolver{
+		"Query.__type":           queryType,
+		"Query.agentToken":       queryAgentToken,
+		"Query.node":             queryNode,
+		"Query.organization":     queryOrganization,
+		"Query.pipeline":         queryPipeline,
+		"Query.pipelineSchedule": queryPipelineSchedule,
+		"Query.registry":         queryRegistry,
+		"Query.rule":             queryRule,
+		"Query.team":             queryTeam,
+		"Query.viewer":           queryViewer,
+
+		"Cluster.agentTokens":            clusterAgentTokens,
+		"Cluster.queues":                 clusterQueues,
+		"Organization.agents":            organizationAgents,
+		"Organization.banners":           organizationBanners,
+		"Organization.cluster":           organizationCluster,
+		"Organization.clusters":          organizationClusters,
+		"Organization.jobs":              organizationJobs,
+		"Organization.members":           organizationMembers,
+		"Organization.pipelineTemplates": organizationPipelineTemplates,
+		"Organization.pipelines":         organizationPipelines,
+		"Organization.rules":             organizationRules,
+		"Organization.teams":             organizationTeams,
+		"Pipeline.schedules":             pipelineSchedules,
+		"Pipeline.teams":                 pipelineTeams,
+		"Suite.teams":                    suiteTeams,
+		"Team.members":                   teamMembers,
+
+		"Mutation.agentTokenCreate":                                           agentTokenCreate,
+		"Mutation.agentStop":                                                  agentStop,
+		"Mutation.agentTokenRevoke":                                           agentTokenRevoke,
+		"Mutation.clusterAgentTokenCreate":                                    clusterAgentTokenCreate,
+		"Mutation.clusterAgentTokenRevoke":                                    clusterAgentTokenRevoke,
+		"Mutation.clusterAgentTokenUpdate":                                    clusterAgentTokenUpdate,
+		"Mutation.clusterCreate":                                              clusterCreate,
+		"Mutation.clusterDelete":                                              clusterDelete,
+		"Mutation.clusterQueueCreate":                                         clusterQueueCreate,
+		"Mutation.clusterQueueDelete":                                         clusterQueueDelete,
+		"Mutation.clusterQueuePauseDispatch":                                  clusterQueuePauseDispatch,
+		"Mutation.clusterQueueResumeDispatch":                                 clusterQueueResumeDispatch,
+		"Mutation.clusterQueueUpdate":                                         clusterQueueUpdate,
+		"Mutation.clusterUpdate":                                              clusterUpdate,
+		"Mutation.organizationApiIpAllowlistUpdate":                           organizationApiIpAllowlistUpdate,
+		"Mutation.organizationBannerDelete":                                   organizationBannerDelete,
+		"Mutation.organizationBannerUpsert":                                   organizationBannerUpsert,
+		"Mutation.organizationEnforceTwoFactorAuthenticationForMembersUpdate": organizationEnforceTwoFactorAuthenticationForMembersUpdate,
+		"Mutation.pipelineArchive":                                            pipelineArchive,
+		"Mutation.pipelineCreate":                                             pipelineCreate,
+		"Mutation.pipelineDelete":                                             pipelineDelete,
+		"Mutation.pipelineScheduleCreate":                                     pipelineScheduleCreate,
+		"Mutation.pipelineScheduleDelete":                                     pipelineScheduleDelete,
+		"Mutation.pipelineScheduleUpdate":                                     pipelineScheduleUpdate,
+		"Mutation.pipelineTemplateCreate":                                     pipelineTemplateCreate,
+		"Mutation.pipelineTemplateDelete":                                     pipelineTemplateDelete,
+		"Mutation.pipelineTemplateUpdate":                                     pipelineTemplateUpdate,
+		"Mutation.pipelineUpdate":                                             pipelineUpdate,
+		"Mutation.ruleCreate":                                                 ruleCreate,
+		"Mutation.ruleDelete":                                                 ruleDelete,
+		"Mutation.ruleUpdate":                                                 ruleUpdate,
+		"Mutation.teamCreate":                                                 teamCreate,
+		"Mutation.teamDelete":                                                 teamDelete,
+		"Mutation.teamMemberCreate":                                           teamMemberCreate,
+		"Mutation.teamMemberDelete":                                           teamMemberDelete,
+		"Mutation.teamMemberUpdate":                                           teamMemberUpdate,
+		"Mutation.teamPipelineCreate":                                         teamPipelineCreate,
+		"Mutation.teamPipelineDelete":                                         teamPipelineDelete,
+		"Mutation.teamPipelineUpdate":                                         teamPipelineUpdate,
+		"Mutation.teamSuiteCreate":                                            teamSuiteCreate,
+		"Mutation.teamSuiteDelete":                                            teamSuiteDelete,
+		"Mutation.teamSuiteUpdate":                                            teamSuiteUpdate,
+		"Mutation.teamUpdate":                                                 teamUpdate,
+	}
+}
+
+// Queries
+
+// queryType introspects the HostedAgentInstanceShapeName enum, the only type the provider reads from the schema
+func queryType(s *Server, _ object, args map[string]any) (any, error) {
+	if args["name"] != "HostedAgentInstanceShapeName" {
+		return nil, nil
+	}
+	return object{
+		"__typename": "__Type",
+		"kind":       "ENUM",
+		"name":       "HostedAgentInstanceShapeName",
+		"enumValues": slices.Clone(s.shapes),
+	}, nil
+}
+
+func queryAgentToken(s *Server, _ object, args map[string]any) (any, error) {
+	uuid, err := s.scopedSlug(args["slug"])
+	if err != nil {
+		return nil, err
+	}
+	return s.find("AgentToken", byField("uuid", uuid)), nil
+}
+
+func queryNode(s *Server, _ object, args map[string]any) (any, error) {
+	id, _ := args["id"].(string)
+	return s.nodes[id], nil
+}
+
+func queryOrganization(s *Server, _ object, args map[string]any) (any, error) {
+	if args["slug"] == nil && args["uuid"] == nil {
+		return nil, errorf("Either a slug or a uuid must be provided to find an organization")
+	}
+	if args["slug"] == s.Organization || args["uuid"] == s.org["uuid"] {
+		return s.org, nil
+	}
+	return nil, nil
+}
+
+func queryPipeline(s *Server, _ object, args map[string]any) (any, error) {
+	slug, err := s.scopedSlug(args["slug"])
+	if err != nil {
+		return nil, err
+	}
+	return s.findPipeline(slug), nil
+}
+
+// findPipeline looks a pipeline up by slug. As with Buildkite, slugs are matched case insensitively and a pipeline can
+// still be found by the slugs it had before it was renamed.
+func (s *Server) findPipeline(slug string) object {
+	slug = strings.ToLower(slug)
+	if pipeline := s.find("Pipeline", byField("slug", slug)); pipeline != nil {
+		return pipeline
+	}
+	return s.find("Pipeline", func(o object) bool {
+		previous, _ := o["previousSlugs"].([]string)
+		return slices.Contains(previous, slug)
+	})
+}
+
+// setPipelineSlug changes the slug of a pipeline, remembering the old one so it continues to resolve.
+func setPipelineSlug(pipeline object, slug string) {
+	previous, _ := pipeline["previousSlugs"].([]string)
+	pipeline["previousSlugs"] = append(previous, pipeline["slug"].(string))
+	pipeline["slug"] = slug
+}
+
+func queryPipelineSchedule(s *Server, _ object, args map[string]any) (any, error) {
+	slug, err := s.scopedSlug(args["slug"])
+	if err != nil {
+		return nil, err
+	}
+	// schedule slugs are made up of the pipeline slug and the schedule UUID
+	parts := strings.Split(slug, "/")
+	return s.find("PipelineSchedule", byField("uuid", parts[len(parts)-1])), nil
+}
+
+func queryRegistry(s *Server, _ object, args map[string]any) (any, error) {
+	if uuid, ok := args["uuid"]; ok {
+		return s.find("Registry", byField("uuid", uuid)), nil
+	}
+	slug, err := s.scopedSlug(args["slug"])
+	if err != nil {
+		return nil, err
+	}
+	return s.find("Registry", byField("slug", slug)), nil
+}
+
+func queryRule(s *Server, _ object, args map[string]any) (any, error) {
+	return s.find("Rule", byField("uuid", args["uuid"])), nil
+}
+
+func queryTeam(s *Server, _ object, args map[string]any) (any, error) {
+	slug, err := s.scopedSlug(args["slug"])
+	if err != nil {
+		return nil, err
+	}
+	return s.find("Team", byField("slug", slug)), nil
+}
+
+func queryViewer(s *Server, _ object, _ map[string]any) (any, error) {
+	return s.viewer, nil
+}
+
+// Connections
+
+func clusterAgentTokens(s *Server, cluster object, args map[string]any) (any, error) {
+	return connection("ClusterToken", s.list("ClusterToken", byRef("cluster", cluster)), args), nil
+}
+
+func clusterQueues(s *Server, cluster object, args map[string]any) (any, error) {
+	return connection("ClusterQueue", sortBy(s.list("ClusterQueue", byRef("cluster", cluster)), "key"), args), nil
+}
+
+func organizationAgents(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("Agent", s.list("Agent", inClusterQueues(args["clusterQueue"])), args), nil
+}
+
+func organizationBanners(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("OrganizationBanner", s.list("OrganizationBanner", nil), args), nil
+}
+
+func organizationCluster(s *Server, _ object, args map[string]any) (any, error) {
+	return s.find("Cluster", byField("uuid", args["id"])), nil
+}
+
+func organizationClusters(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("Cluster", sortBy(s.list("Cluster", nil), "name"), args), nil
+}
+
+func organizationJobs(s *Server, _ object, args map[string]any) (any, error) {
+	states, _ := args["state"].([]any)
+	inQueues := inClusterQueues(args["clusterQueue"])
+	jobs := s.list("JobTypeCommand", func(o object) bool {
+		return inQueues(o) && (len(states) == 0 || slices.Contains(states, o["state"]))
+	})
+	return connection("Job", jobs, args), nil
+}
+
+func organizationMembers(s *Server, _ object, args map[string]any) (any, error) {
+	email, _ := args["email"].(string)
+	members := s.list("OrganizationMember", func(o object) bool {
+		return email == "" || strings.EqualFold(o["user"].(object)["email"].(string), email)
+	})
+	return connection("OrganizationMember", members, args), nil
+}
+
+func organizationPipelineTemplates(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("PipelineTemplate", sortBy(s.list("PipelineTemplate", nil), "name"), args), nil
+}
+
+func organizationPipelines(s *Server, _ object, args map[string]any) (any, error) {
+	archived, filtered := args["archived"].(bool)
+	pipelines := s.list("Pipeline", func(o object) bool {
+		return !filtered || o["archived"] == archived
+	})
+	return connection("Pipeline", sortBy(pipelines, "name"), args), nil
+}
+
+func organizationRules(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("Rule", s.list("Rule", nil), args), nil
+}
+
+func organizationTeams(s *Server, _ object, args map[string]any) (any, error) {
+	return connection("Team", sortBy(s.list("Team", nil), "name"), args), nil
+}
+
+func pipelineSchedules(s *Server, pipeline object, args map[string]any) (any, error) {
+	return connection("PipelineSchedule", s.list("PipelineSchedule", byRef("pipeline", pipeline)), args), nil
+}
+
+func pipelineTeams(s *Server, pipeline object, args map[string]any) (any, error) {
+	return connection("TeamPipeline", sortByTeamName(s.list("TeamPipeline", byRef("pipeline", pipeline))), args), nil
+}
+
+func suiteTeams(s *Server, suite object, args map[string]any) (any, error) {
+	return connection("TeamSuite", sortByTeamName(s.list("TeamSuite", byRef("suite", suite))), args), nil
+}
+
+func teamMembers(s *Server, team object, args map[string]any) (any, error) {
+	return connection("TeamMember", s.list("TeamMember", byRef("team", team)), args), nil
+}
+
+// Agents
+
+func agentStop(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	agent, err := s.node("Agent", in["id"])
+	if err != nil {
+		return nil, err
+	}
+	if agent["connectionState"] != "connected" {
+		return nil, errorf("Agent is not connected")
+	}
+
+	if in["graceful"] == true {
+		agent["connectionState"] = "stopping"
+		agent["stoppedGracefullyAt"] = now()
+		agent["stoppedGracefullyBy"] = s.viewer["user"]
+	} else {
+		agent["connectionState"] = "stopped"
+		agent["stoppedAt"] = now()
+		agent["stoppedBy"] = s.viewer["user"]
+	}
+
+	return object{"agent": agent}, nil
+}
+
+// Agent tokens
+
+func agentTokenCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationID"]); err != nil {
+		return nil, err
+	}
+
+	value := newToken()
+	token := s.add("AgentToken", newUUID(), object{
+		"description": in["description"],
+		"token":       value,
+		"revokedAt":   nil,
+	})
+
+	return object{
+		"tokenValue":     value,
+		"agentTokenEdge": object{"node": token},
+	}, nil
+}
+
+func agentTokenRevoke(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	token, err := s.node("AgentToken", in["id"])
+	if err != nil {
+		return nil, err
+	}
+	if token["revokedAt"] != nil {
+		return nil, errorf("This agent registration token was already revoked")
+	}
+
+	token["revokedAt"] = now()
+	token["revokedReason"] = in["reason"]
+
+	return object{"agentToken": token}, nil
+}
+
+// Clusters
+
+func clusterCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	name, _ := in["name"].(string)
+	if name == "" {
+		return nil, errorf("Name can't be blank")
+	}
+	if s.find("Cluster", byField("name", name)) != nil {
+		return nil, errorf("Name has already been taken")
+	}
+
+	cluster := s.add("Cluster", newUUID(), object{
+		"name":         name,
+		"defaultQueue": nil,
+	})
+	set(cluster, in, "description", "emoji", "color")
+
+	return object{"cluster": cluster}, nil
+}
+
+func clusterUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	cluster, err := s.node("Cluster", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if queueID, ok := in["defaultQueueId"]; ok {
+		if queueID == nil {
+			cluster["defaultQueue"] = nil
+		} else {
+			queue, err := s.node("ClusterQueue", queueID)
+			if err != nil {
+				return nil, err
+			}
+			if queue["cluster"].(object)["id"] != cluster["id"] {
+				return nil, errorf("Queue does not belong to this cluster")
+			}
+			cluster["defaultQueue"] = queue
+		}
+	}
+	if name, ok := in["name"].(string); ok && name != "" {
+		cluster["name"] = name
+	}
+	set(cluster, in, "description", "emoji", "color")
+
+	return object{"cluster": cluster}, nil
+}
+
+func clusterDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	cluster, err := s.node("Cluster", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(cluster["id"].(string))
+	return object{"deletedClusterId": cluster["id"]}, nil
+}
+
+// Cluster queues
+
+func clusterQueueCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	cluster, err := s.node("Cluster", in["clusterId"])
+	if err != nil {
+		return nil, err
+	}
+	key, _ := in["key"].(string)
+	if key == "" {
+		return nil, errorf("Key can't be blank")
+	}
+	if len(s.list("ClusterQueue", func(o object) bool {
+		return o["key"] == key && o["cluster"].(object)["id"] == cluster["id"]
+	})) > 0 {
+		return nil, errorf("Key has already been taken")
+	}
+
+	queue := s.add("ClusterQueue", newUUID(), object{
+		"key":                key,
+		"cluster":            cluster,
+		"hosted":             false,
+		"hostedAgents":       nil,
+		"dispatchPaused":     false,
+		"dispatchPausedAt":   nil,
+		"dispatchPausedBy":   nil,
+		"dispatchPausedNote": nil,
+	})
+	set(queue, in, "description")
+
+	if hosted, ok := in["hostedAgents"].(map[string]any); ok {
+		settings, err := hostedAgentSettings(nil, hosted)
+		if err != nil {
+			s.remove(queue["id"].(string))
+			return nil, err
+		}
+		queue["hosted"] = true
+		queue["hostedAgents"] = settings
+	}
+
+	return object{"clusterQueue": queue}, nil
+}
+
+func clusterQueueUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	queue, err := s.node("ClusterQueue", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if hosted, ok := in["hostedAgents"].(map[string]any); ok {
+		if queue["hosted"] != true {
+			return nil, errorf("Hosted agent settings can only be changed on hosted queues")
+		}
+		settings, err := hostedAgentSettings(queue["hostedAgents"].(object), hosted)
+		if err != nil {
+			return nil, err
+		}
+		queue["hostedAgents"] = settings
+	}
+	set(queue, in, "description")
+
+	return object{"clusterQueue": queue}, nil
+}
+
+func clusterQueueDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	queue, err := s.node("ClusterQueue", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := queue["cluster"].(object)
+	if defaultQueue, ok := cluster["defaultQueue"].(object); ok && defaultQueue["id"] == queue["id"] {
+		cluster["defaultQueue"] = nil
+	}
+
+	s.remove(queue["id"].(string))
+	return object{"deletedClusterQueueId": queue["id"]}, nil
+}
+
+func clusterQueuePauseDispatch(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	queue, err := s.node("ClusterQueue", in["id"])
+	if err != nil {
+		return nil, err
+	}
+	if queue["dispatchPaused"] == true {
+		return nil, errorf("Dispatch is already paused for this queue")
+	}
+
+	queue["dispatchPaused"] = true
+	queue["dispatchPausedAt"] = now()
+	queue["dispatchPausedBy"] = s.viewer["user"]
+	queue["dispatchPausedNote"] = in["note"]
+
+	return object{"queue": queue}, nil
+}
+
+func clusterQueueResumeDispatch(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	queue, err := s.node("ClusterQueue", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	queue["dispatchPaused"] = false
+	queue["dispatchPausedAt"] = nil
+	queue["dispatchPausedBy"] = nil
+	queue["dispatchPausedNote"] = nil
+
+	return object{"queue": queue}, nil
+}
+
+// hostedAgentSettings applies hosted agent settings input to the existing settings, if any.
+func hostedAgentSettings(existing object, in map[string]any) (object, error) {
+	settings := object{
+		"__typename":       "HostedAgentQueueSettings",
+		"instanceShape":    nil,
+		"platformSettings": object{"linux": object{"agentImageRef": nil}, "macos": object{"xcodeVersion": nil}},
+	}
+	if existing != nil {
+		settings = existing
+	}
+
+	if name, ok := in["instanceShape"].(string); ok {
+		shape, err := instanceShape(name)
+		if err != nil {
+			return nil, err
+		}
+		settings["instanceShape"] = shape
+	}
+
+	platform, _ := in["platformSettings"].(map[string]any)
+	current := settings["platformSettings"].(object)
+	if linux, ok := platform["linux"].(map[string]any); ok {
+		set(current["linux"].(object), linux, "agentImageRef")
+	}
+	if macos, ok := platform["macos"].(map[string]any); ok {
+		set(current["macos"].(object), macos, "xcodeVersion")
+	}
+
+	return settings, nil
+}
+
+// instanceShape expands a shape name such as LINUX_AMD64_2X4 into its component parts.
+func instanceShape(name string) (object, error) {
+	parts := strings.Split(name, "_")
+	if len(parts) != 3 {
+		return nil, errorf("%q is not a valid instance shape", name)
+	}
+
+	vcpu, memory, ok := strings.Cut(parts[2], "X")
+	cpus, cpuErr := strconv.Atoi(vcpu)
+	mem, memErr := strconv.Atoi(memory)
+	if !ok || cpuErr != nil || memErr != nil {
+		return nil, errorf("%q is not a valid instance shape", name)
+	}
+
+	size := "SMALL"
+	switch {
+	case cpus >= 16:
+		size = "XLARGE"
+	case cpus >= 8:
+		size = "LARGE"
+	case cpus >= 4:
+		size = "MEDIUM"
+	}
+
+	return object{
+		"__typename":   "HostedAgentInstanceShape",
+		"name":         name,
+		"machineType":  parts[0],
+		"architecture": parts[1],
+		"size":         size,
+		"vcpu":         cpus,
+		"memory":       mem,
+	}, nil
+}
+
+// Cluster agent tokens
+
+func clusterAgentTokenCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	cluster, err := s.node("Cluster", in["clusterId"])
+	if err != nil {
+		return nil, err
+	}
+
+	value := newToken()
+	token := s.add("ClusterToken", newUUID(), object{
+		"cluster":            cluster,
+		"description":        in["description"],
+		"allowedIpAddresses": in["allowedIpAddresses"],
+		"token":              value,
+	})
+
+	return object{
+		"clusterAgentToken": token,
+		"tokenValue":        value,
+	}, nil
+}
+
+func clusterAgentTokenUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	token, err := s.node("ClusterToken", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(token, in, "description", "allowedIpAddresses")
+
+	return object{"clusterAgentToken": token}, nil
+}
+
+func clusterAgentTokenRevoke(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	token, err := s.node("ClusterToken", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(token["id"].(string))
+	return object{"deletedClusterAgentTokenId": token["id"]}, nil
+}
+
+// Organization
+
+func organizationApiIpAllowlistUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationID"]); err != nil {
+		return nil, err
+	}
+
+	s.org["allowedApiIpAddresses"] = in["ipAddresses"]
+
+	return object{"organization": s.org}, nil
+}
+
+func organizationEnforceTwoFactorAuthenticationForMembersUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+
+	s.org["membersRequireTwoFactorAuthentication"] = in["membersRequireTwoFactorAuthentication"] == true
+
+	return object{"organization": s.org}, nil
+}
+
+func organizationBannerUpsert(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+
+	banner := s.find("OrganizationBanner", nil)
+	if banner == nil {
+		banner = s.add("OrganizationBanner", newUUID(), object{})
+	}
+	banner["message"] = in["message"]
+
+	return object{"banner": banner}, nil
+}
+
+func organizationBannerDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+
+	banner := s.find("OrganizationBanner", nil)
+	if banner == nil {
+		return nil, errorf("No organization banner found")
+	}
+
+	s.remove(banner["id"].(string))
+	return object{"deletedBannerId": banner["id"]}, nil
+}
+
+// Pipelines
+
+func pipelineCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	name, _ := in["name"].(string)
+	if name == "" {
+		return nil, errorf("Name can't be blank")
+	}
+	if repository, _ := in["repository"].(map[string]any); repository["url"] == "" || repository["url"] == nil {
+		return nil, errorf("Repository can't be blank")
+	}
+	derived := slug.FromName(name)
+	if s.find("Pipeline", byField("slug", derived)) != nil {
+		return nil, errorf("Name has already been taken")
+	}
+
+	webhookToken := newToken()
+	pipeline := s.add("Pipeline", newUUID(), object{
+		"name":                                 name,
+		"slug":                                 derived,
+		"description":                          "",
+		"defaultBranch":                        "",
+		"allowRebuilds":                        true,
+		"branchConfiguration":                  nil,
+		"cancelIntermediateBuilds":             false,
+		"cancelIntermediateBuildsBranchFilter": "",
+		"skipIntermediateBuilds":               false,
+		"skipIntermediateBuildsBranchFilter":   "",
+		"color":                                nil,
+		"emoji":                                nil,
+		"defaultTimeoutInMinutes":              nil,
+		"maximumTimeoutInMinutes":              nil,
+		"cluster":                              nil,
+		"pipelineTemplate":                     nil,
+		"tags":                                 []object{},
+		"visibility":                           "PRIVATE",
+		"archived":                             false,
+		"webhookURL":                           "https://webhook.buildkite.com/deliver/" + webhookToken,
+		"badgeURL":                             "https://badge.buildkite.com/" + webhookToken + ".svg",
+		"providerSettings":                     defaultProviderSettings(),
+	})
+
+	if err := s.applyPipelineInput(pipeline, in); err != nil {
+		s.remove(pipeline["id"].(string))
+		return nil, err
+	}
+
+	teams, _ := in["teams"].([]any)
+	for _, t := range teams {
+		assignment, _ := t.(map[string]any)
+		team, err := s.node("Team", assignment["id"])
+		if err != nil {
+			s.remove(pipeline["id"].(string))
+			return nil, err
+		}
+		s.addTeamPipeline(team, pipeline, assignment["accessLevel"])
+	}
+
+	return object{"pipeline": pipeline}, nil
+}
+
+func pipelineUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	pipeline, err := s.node("Pipeline", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := in["name"].(string); ok && name != "" {
+		// updating a pipeline through GraphQL always derives its slug from the name again, discarding any slug set
+		// through the REST API
+		if derived := slug.FromName(name); derived != pipeline["slug"] {
+			if other := s.find("Pipeline", byField("slug", derived)); other != nil && other["id"] != pipeline["id"] {
+				return nil, errorf("Name has already been taken")
+			}
+			setPipelineSlug(pipeline, derived)
+		}
+		pipeline["name"] = name
+	}
+
+	if err := s.applyPipelineInput(pipeline, in); err != nil {
+		return nil, err
+	}
+	set(pipeline, in, "archived")
+
+	return object{"pipeline": pipeline}, nil
+}
+
+// applyPipelineInput applies the fields shared by the pipeline create and update inputs.
+func (s *Server) applyPipelineInput(pipeline object, in map[string]any) error {
+	set(pipeline, in,
+		"description", "defaultBranch", "allowRebuilds", "branchConfiguration", "cancelIntermediateBuilds",
+		"cancelIntermediateBuildsBranchFilter", "skipIntermediateBuilds", "skipIntermediateBuildsBranchFilter",
+		"color", "emoji", "defaultTimeoutInMinutes", "maximumTimeoutInMinutes", "visibility", "repository", "tags",
+	)
+
+	if id, ok := in["clusterId"]; ok {
+		cluster, err := s.optionalNode("Cluster", id)
+		if err != nil {
+			return err
+		}
+		pipeline["cluster"] = cluster
+	}
+
+	if id, ok := in["pipelineTemplateId"]; ok {
+		template, err := s.optionalNode("PipelineTemplate", id)
+		if err != nil {
+			return err
+		}
+		pipeline["pipelineTemplate"] = template
+	}
+
+	// pipelines using a template always report the template's configuration as their steps
+	if template, ok := pipeline["pipelineTemplate"].(object); ok && template != nil {
+		pipeline["steps"] = object{"yaml": template["configuration"]}
+	} else if steps, ok := in["steps"]; ok {
+		pipeline["steps"] = toObject(steps)
+	}
+
+	return nil
+}
+
+func pipelineArchive(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	pipeline, err := s.node("Pipeline", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline["archived"] = true
+	return object{"pipeline": pipeline}, nil
+}
+
+func pipelineDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	pipeline, err := s.node("Pipeline", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(pipeline["id"].(string))
+	return object{"deletedPipelineID": pipeline["id"]}, nil
+}
+
+func defaultProviderSettings() map[string]any {
+	return map[string]any{
+		"trigger_mode":                                  "code",
+		"build_pull_requests":                           true,
+		"pull_request_branch_filter_enabled":            false,
+		"pull_request_branch_filter_configuration":      "",
+		"skip_builds_for_existing_commits":              false,
+		"skip_pull_request_builds_for_existing_commits": true,
+		"build_pull_request_ready_for_review":           false,
+		"build_pull_request_labels_changed":             false,
+		"build_pull_request_forks":                      false,
+		"prefix_pull_request_fork_branch_names":         true,
+		"build_branches":                                true,
+		"build_tags":                                    false,
+		"cancel_deleted_branch_builds":                  false,
+		"filter_enabled":                                false,
+		"filter_condition":                              "",
+		"publish_commit_status":                         true,
+		"publish_blocked_as_pending":                    false,
+		"publish_commit_status_per_step":                false,
+		"separate_pull_request_statuses":                false,
+	}
+}
+
+// Pipeline schedules
+
+func pipelineScheduleCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	pipeline, err := s.node("Pipeline", in["pipelineID"])
+	if err != nil {
+		return nil, err
+	}
+	if cronline, _ := in["cronline"].(string); cronline == "" {
+		return nil, errorf("Cronline can't be blank")
+	}
+
+	schedule := s.add("PipelineSchedule", newUUID(), object{
+		"pipeline": pipeline,
+		"enabled":  true,
+	})
+	set(schedule, in, "label", "cronline", "message", "commit", "branch")
+	if env, ok := in["env"]; ok {
+		schedule["env"] = scheduleEnv(env)
+	}
+	if enabled, ok := in["enabled"].(bool); ok {
+		schedule["enabled"] = enabled
+	}
+
+	return object{
+		"pipeline":             pipeline,
+		"pipelineScheduleEdge": object{"node": schedule},
+	}, nil
+}
+
+func pipelineScheduleUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	schedule, err := s.node("PipelineSchedule", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(schedule, in, "label", "cronline", "message", "commit", "branch")
+	if env, ok := in["env"]; ok {
+		schedule["env"] = scheduleEnv(env)
+	}
+	if enabled, ok := in["enabled"].(bool); ok {
+		schedule["enabled"] = enabled
+	}
+
+	return object{"pipelineSchedule": schedule}, nil
+}
+
+func pipelineScheduleDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	schedule, err := s.node("PipelineSchedule", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(schedule["id"].(string))
+	return object{"deletedPipelineScheduleID": schedule["id"]}, nil
+}
+
+// scheduleEnv parses the newline separated KEY=value pairs schedules accept into the list the API returns them as.
+// Values may be double quoted.
+func scheduleEnv(v any) any {
+	str, _ := v.(string)
+	if str == "" {
+		return nil
+	}
+
+	var env []string
+	for _, line := range strings.Split(str, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		env = append(env, key+"="+value)
+	}
+	return env
+}
+
+// Pipeline templates
+
+func pipelineTemplateCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	name, _ := in["name"].(string)
+	if name == "" {
+		return nil, errorf("Name can't be blank")
+	}
+
+	template := s.add("PipelineTemplate", newUUID(), object{
+		"name":        name,
+		"description": nil,
+		"available":   false,
+	})
+	set(template, in, "configuration", "description", "available")
+
+	return object{"pipelineTemplate": template}, nil
+}
+
+func pipelineTemplateUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	template, err := s.node("PipelineTemplate", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(template, in, "name", "configuration", "description", "available")
+
+	return object{"pipelineTemplate": template}, nil
+}
+
+func pipelineTemplateDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	template, err := s.node("PipelineTemplate", in["id"])
+	if err != nil {
+		return nil, err
+	}
+	if s.find("Pipeline", byRef("pipelineTemplate", template)) != nil {
+		return nil, errorf("Pipeline template is in use by one or more pipelines")
+	}
+
+	s.remove(template["id"].(string))
+	return object{"deletedPipelineTemplateId": template["id"]}, nil
+}
+
+// Rules
+
+var ruleActions = map[string]string{
+	"pipeline.trigger_build.pipeline":  "TRIGGER_BUILD",
+	"pipeline.artifacts_read.pipeline": "ARTIFACTS_READ",
+}
+
+func ruleCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	ruleType, _ := in["type"].(string)
+	action, ok := ruleActions[ruleType]
+	if !ok {
+		return nil, argumentError("Rule type is unknown")
+	}
+
+	rule := s.add("Rule", newUUID(), object{
+		"type":        ruleType,
+		"sourceType":  "PIPELINE",
+		"targetType":  "PIPELINE",
+		"effect":      "ALLOW",
+		"action":      action,
+		"description": nil,
+	})
+	if err := s.applyRuleValue(rule, in["value"]); err != nil {
+		s.remove(rule["id"].(string))
+		return nil, err
+	}
+	set(rule, in, "description")
+
+	return object{"rule": rule}, nil
+}
+
+func ruleUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	rule, err := s.node("Rule", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok := in["value"]; ok {
+		if err := s.applyRuleValue(rule, value); err != nil {
+			return nil, err
+		}
+	}
+	set(rule, in, "description")
+
+	return object{"rule": rule}, nil
+}
+
+func ruleDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationId"]); err != nil {
+		return nil, err
+	}
+	rule, err := s.node("Rule", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(rule["id"].(string))
+	return object{"deletedRuleId": rule["id"]}, nil
+}
+
+// ruleVariables are the variables rule conditions may reference.
+var ruleVariables = map[string]bool{
+	"source.build.branch":        true,
+	"source.build.commit":        true,
+	"source.build.creator.email": true,
+	"source.build.creator.teams": true,
+	"source.build.message":       true,
+	"source.build.source":        true,
+	"source.pipeline.slug":       true,
+	"source.pipeline.uuid":       true,
+	"target.pipeline.slug":       true,
+	"target.pipeline.uuid":       true,
+}
+
+var ruleVariablePattern = regexp.MustCompile(`\b(?:source|target)(?:\.[a-z_]+)+`)
+
+// applyRuleValue validates a rule's JSON value, resolves the pipelines it references and stores the rule document.
+// Errors are prefixed with the rule type, as they are by the API.
+func (s *Server) applyRuleValue(rule object, raw any) error {
+	str, _ := raw.(string)
+
+	var value map[string]any
+	if err := json.Unmarshal([]byte(str), &value); err != nil {
+		return errorf("%s: value is not valid JSON", rule["type"])
+	}
+
+	pipelines := make(map[string]object)
+	for _, side := range []string{"source", "target"} {
+		key := side + "_pipeline"
+		ref, ok := value[key].(string)
+		if !ok || ref == "" {
+			return errorf("%s: missing %s", rule["type"], key)
+		}
+		pipeline := s.find("Pipeline", func(o object) bool {
+			return o["uuid"] == ref || o["slug"] == ref
+		})
+		if pipeline == nil {
+			return errorf("%s: %s not found", rule["type"], key)
+		}
+		pipelines[side] = pipeline
+	}
+
+	if conditions, ok := value["conditions"]; ok && conditions != nil {
+		list, ok := conditions.([]any)
+		if !ok {
+			return errorf("%s: conditions must be an array of strings", rule["type"])
+		}
+		for _, condition := range list {
+			str, _ := condition.(string)
+			for _, variable := range ruleVariablePattern.FindAllString(str, -1) {
+				if !ruleVariables[variable] {
+					return errorf("%s: conditional is invalid:\n`%s` is not a variable", rule["type"], variable)
+				}
+			}
+		}
+	}
+
+	document, err := json.Marshal(map[string]any{"rule": rule["type"], "value": value})
+	if err != nil {
+		return err
+	}
+	rule["source"] = pipelines["source"]
+	rule["target"] = pipelines["target"]
+	rule["document"] = string(document)
+	return nil
+}
+
+// Teams
+
+func teamCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	if err := s.checkOrganization(in["organizationID"]); err != nil {
+		return nil, err
+	}
+	name, _ := in["name"].(string)
+	if name == "" {
+		return nil, errorf("Name can't be blank")
+	}
+	derived := slug.FromName(name)
+	if s.find("Team", byField("slug", derived)) != nil {
+		return nil, errorf("Name has already been taken")
+	}
+
+	team := s.add("Team", newUUID(), object{
+		"name":                      name,
+		"slug":                      derived,
+		"description":               "",
+		"membersCanCreatePipelines": false,
+	})
+	set(team, in, "description", "privacy", "isDefaultTeam", "defaultMemberRole", "membersCanCreatePipelines")
+
+	return object{"teamEdge": object{"node": team}}, nil
+}
+
+func teamUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	team, err := s.node("Team", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := in["name"].(string); ok && name != "" && name != team["name"] {
+		derived := slug.FromName(name)
+		if other := s.find("Team", byField("slug", derived)); other != nil && other["id"] != team["id"] {
+			return nil, errorf("Name has already been taken")
+		}
+		team["name"] = name
+		team["slug"] = derived
+	}
+	set(team, in, "description", "privacy", "isDefaultTeam", "defaultMemberRole", "membersCanCreatePipelines")
+
+	return object{"team": team}, nil
+}
+
+func teamDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	team, err := s.node("Team", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(team["id"].(string))
+	return object{"deletedTeamID": team["id"]}, nil
+}
+
+// Team members
+
+func teamMemberCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	team, err := s.node("Team", in["teamID"])
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.node("User", in["userID"])
+	if err != nil {
+		return nil, err
+	}
+	if s.find("TeamMember", func(o object) bool {
+		return byRef("team", team)(o) && byRef("user", user)(o)
+	}) != nil {
+		return nil, errorf("User is already a member of this team")
+	}
+
+	role := in["role"]
+	if role == nil {
+		role = team["defaultMemberRole"]
+	}
+	member := s.add("TeamMember", newUUID(), object{
+		"team": team,
+		"user": user,
+		"role": role,
+	})
+
+	return object{"teamMemberEdge": object{"node": member}}, nil
+}
+
+func teamMemberUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	member, err := s.node("TeamMember", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(member, in, "role")
+
+	return object{"teamMember": member}, nil
+}
+
+func teamMemberDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	member, err := s.node("TeamMember", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(member["id"].(string))
+	return object{"deletedTeamMemberID": member["id"]}, nil
+}
+
+// Team pipelines
+
+func teamPipelineCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	team, err := s.node("Team", in["teamID"])
+	if err != nil {
+		return nil, err
+	}
+	pipeline, err := s.node("Pipeline", in["pipelineID"])
+	if err != nil {
+		return nil, err
+	}
+	if s.find("TeamPipeline", func(o object) bool {
+		return byRef("team", team)(o) && byRef("pipeline", pipeline)(o)
+	}) != nil {
+		return nil, errorf("Team already has access to this pipeline")
+	}
+
+	teamPipeline := s.addTeamPipeline(team, pipeline, in["accessLevel"])
+
+	return object{"teamPipelineEdge": object{"node": teamPipeline}}, nil
+}
+
+func (s *Server) addTeamPipeline(team, pipeline object, accessLevel any) object {
+	if accessLevel == nil {
+		accessLevel = "MANAGE_BUILD_AND_READ"
+	}
+	return s.add("TeamPipeline", newUUID(), object{
+		"team":        team,
+		"pipeline":    pipeline,
+		"accessLevel": accessLevel,
+	})
+}
+
+func teamPipelineUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	teamPipeline, err := s.node("TeamPipeline", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(teamPipeline, in, "accessLevel")
+
+	return object{"teamPipeline": teamPipeline}, nil
+}
+
+func teamPipelineDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	teamPipeline, err := s.node("TeamPipeline", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(teamPipeline["id"].(string))
+	return object{"deletedTeamPipelineID": teamPipeline["id"]}, nil
+}
+
+// Team suites
+
+func teamSuiteCreate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	team, err := s.node("Team", in["teamID"])
+	if err != nil {
+		return nil, err
+	}
+	suite, err := s.node("Suite", in["suiteID"])
+	if err != nil {
+		return nil, err
+	}
+	if s.find("TeamSuite", func(o object) bool {
+		return byRef("team", team)(o) && byRef("suite", suite)(o)
+	}) != nil {
+		return nil, errorf("Team already has access to this suite")
+	}
+
+	teamSuite := s.addTeamSuite(team, suite, in["accessLevel"])
+
+	return object{"suite": suite, "teamSuite": teamSuite}, nil
+}
+
+func (s *Server) addTeamSuite(team, suite object, accessLevel any) object {
+	if accessLevel == nil {
+		accessLevel = "MANAGE_AND_READ"
+	}
+	return s.add("TeamSuite", newUUID(), object{
+		"team":        team,
+		"suite":       suite,
+		"accessLevel": accessLevel,
+	})
+}
+
+func teamSuiteUpdate(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	teamSuite, err := s.node("TeamSuite", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	set(teamSuite, in, "accessLevel")
+
+	return object{"teamSuite": teamSuite}, nil
+}
+
+func teamSuiteDelete(s *Server, _ object, args map[string]any) (any, error) {
+	in := input(args)
+	teamSuite, err := s.node("TeamSuite", in["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove(teamSuite["id"].(string))
+	return object{"deletedTeamSuiteID": teamSuite["id"], "team": teamSuite["team"]}, nil
+}
+
+// Helpers
+
+// scopedSlug strips the organization prefix from a slug such as "org/pipeline". Slugs for other organizations are
+// rejected the same way the real API rejects them.
+func (s *Server) scopedSlug(v any) (string, error) {
+	slug, _ := v.(string)
+	org, rest, ok := strings.Cut(slug, "/")
+	if !ok {
+		return slug, nil
+	}
+	if org != s.Organization {
+		return "", errorf("No organization found with slug %q", org)
+	}
+	return rest, nil
+}
+
+func (s *Server) checkOrganization(id any) error {
+	if id != s.org["id"] {
+		return errorf("No organization found with ID %q", id)
+	}
+	return nil
+}
+
+// optionalNode looks up a node for an input field that may be null or empty to clear a relationship.
+func (s *Server) optionalNode(typename string, id any) (object, error) {
+	if id == nil || id == "" {
+		return nil, nil
+	}
+	return s.node(typename, id)
+}
+
+func input(args map[string]any) map[string]any {
+	in, _ := args["input"].(map[string]any)
+	if in == nil {
+		in = map[string]any{}
+	}
+	return in
+}
+
+// set copies the given input fields onto obj when they were supplied, including explicit nulls.
+func set(obj object, in map[string]any, keys ...string) {
+	for _, key := range keys {
+		if v, ok := in[key]; ok {
+			obj[key] = toObject(v)
+		}
+	}
+}
+
+// toObject converts decoded JSON input into objects so nested input values can be selected from.
+func toObject(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		obj := make(object, len(val))
+		for k, item := range val {
+			obj[k] = toObject(item)
+		}
+		return obj
+	case []any:
+		if len(val) == 0 {
+			return []object{}
+		}
+		if _, ok := val[0].(map[string]any); !ok {
+			return val
+		}
+		list := make([]object, len(val))
+		for i, item := range val {
+			list[i], _ = toObject(item).(object)
+		}
+		return list
+	}
+	return v
+}
+
+func byField(field string, value any) func(object) bool {
+	return func(o object) bool {
+		return o[field] == value
+	}
+}
+
+func byRef(field string, target object) func(object) bool {
+	return func(o object) bool {
+		ref, ok := o[field].(object)
+		return ok && ref != nil && ref["id"] == target["id"]
+	}
+}
+
+// inClusterQueues matches nodes in any of the cluster queues with the given IDs, or every node if there are none.
+func inClusterQueues(ids any) func(object) bool {
+	queueIDs, _ := ids.([]any)
+	return func(o object) bool {
+		if len(queueIDs) == 0 {
+			return true
+		}
+		queue, _ := o["clusterQueue"].(object)
+		return queue != nil && slices.Contains(queueIDs, queue["id"])
+	}
+}
+
+func sortByTeamName(nodes []object) []object {
+	sorted := append([]object(nil), nodes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a := sorted[i]["team"].(object)["name"].(string)
+		b := sorted[j]["team"].(object)["name"].(string)
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return sorted
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,394 @@
+package fakebuildkite
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
+)
+
+// serveREST routes the REST API endpoints the provider uses.
+func (s *Server) serveREST(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v2/"), "/"), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "access-token":
+		s.serveAccessToken(w, r)
+	case len(parts) == 1 && parts[0] == "meta":
+		s.serveMeta(w, r)
+	case len(parts) == 4 && parts[0] == "organizations" && parts[2] == "pipelines":
+		if s.checkRESTOrganization(w, parts[1]) {
+			s.servePipeline(w, r, parts[3])
+		}
+	case len(parts) >= 4 && parts[0] == "analytics" && parts[1] == "organizations" && parts[3] == "suites":
+		if s.checkRESTOrganization(w, parts[2]) {
+			s.serveSuites(w, r, parts[4:])
+		}
+	case len(parts) >= 4 && parts[0] == "packages" && parts[1] == "organizations" && parts[3] == "registries":
+		if s.checkRESTOrganization(w, parts[2]) {
+			s.serveRegistries(w, r, parts[4:])
+		}
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) checkRESTOrganization(w http.ResponseWriter, slug string) bool {
+	if slug != s.Organization {
+		notFound(w)
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveAccessToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	user := s.viewer["user"].(object)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"uuid":        s.org["uuid"],
+		"scopes":      s.scopes,
+		"description": "Fake API token",
+		"user": map[string]any{
+			"name":  user["name"],
+			"email": user["email"],
+		},
+	})
+}
+
+func (s *Server) serveMeta(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"webhook_ips": []string{"192.0.2.1/32", "198.51.100.0/24"},
+	})
+}
+
+// Pipelines. Only the settings that cannot be managed through GraphQL are supported.
+
+func (s *Server) servePipeline(w http.ResponseWriter, r *http.Request, slug string) {
+	pipeline := s.findPipeline(slug)
+	if pipeline == nil {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		var body struct {
+			Slug             string         `json:"slug"`
+			ProviderSettings map[string]any `json:"provider_settings"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if body.Slug != "" && body.Slug != pipeline["slug"] {
+			if s.find("Pipeline", byField("slug", body.Slug)) != nil {
+				validationFailed(w, "Slug has already been taken")
+				return
+			}
+			setPipelineSlug(pipeline, body.Slug)
+		}
+
+		settings := pipeline["providerSettings"].(map[string]any)
+		for k, v := range body.ProviderSettings {
+			settings[k] = v
+		}
+	default:
+		methodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":         pipeline["uuid"],
+		"graphql_id": pipeline["id"],
+		"name":       pipeline["name"],
+		"slug":       pipeline["slug"],
+		"badge_url":  pipeline["badgeURL"],
+		"provider": map[string]any{
+			"id":          "github",
+			"webhook_url": pipeline["webhookURL"],
+			"settings":    pipeline["providerSettings"],
+		},
+	})
+}
+
+// Test suites
+
+func (s *Server) serveSuites(w http.ResponseWriter, r *http.Request, rest []string) {
+	if len(rest) == 0 {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.createSuite(w, r)
+		return
+	}
+
+	suite := s.find("Suite", byField("slug", rest[0]))
+	if len(rest) > 1 || suite == nil {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, suiteJSON(suite, false))
+	case http.MethodPatch:
+		var body map[string]any
+		if !decode(w, r, &body) {
+			return
+		}
+		if name, ok := body["name"].(string); ok && name != "" {
+			suite["name"] = name
+		}
+		if branch, ok := body["default_branch"].(string); ok {
+			suite["defaultBranch"] = branch
+		}
+		writeJSON(w, http.StatusOK, suiteJSON(suite, false))
+	case http.MethodDelete:
+		s.remove(suite["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createSuite(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string   `json:"name"`
+		DefaultBranch string   `json:"default_branch"`
+		ShowAPIToken  bool     `json:"show_api_token"`
+		TeamIDs       []string `json:"team_ids"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		validationFailed(w, "Name can't be blank")
+		return
+	}
+	derived := slug.FromName(body.Name)
+	if s.find("Suite", byField("slug", derived)) != nil {
+		validationFailed(w, "Name has already been taken")
+		return
+	}
+
+	var teams []object
+	for _, uuid := range body.TeamIDs {
+		team := s.find("Team", byField("uuid", uuid))
+		if team == nil {
+			validationFailed(w, "Team "+uuid+" could not be found")
+			return
+		}
+		teams = append(teams, team)
+	}
+
+	suite := s.add("Suite", newUUID(), object{
+		"name":          body.Name,
+		"slug":          derived,
+		"defaultBranch": body.DefaultBranch,
+		"apiToken":      newToken(),
+	})
+	for _, team := range teams {
+		s.addTeamSuite(team, suite, "MANAGE_AND_READ")
+	}
+
+	writeJSON(w, http.StatusCreated, suiteJSON(suite, body.ShowAPIToken))
+}
+
+func suiteJSON(suite object, showAPIToken bool) map[string]any {
+	body := map[string]any{
+		"id":             suite["uuid"],
+		"graphql_id":     suite["id"],
+		"slug":           suite["slug"],
+		"name":           suite["name"],
+		"default_branch": suite["defaultBranch"],
+	}
+	if showAPIToken {
+		body["api_token"] = suite["apiToken"]
+	}
+	return body
+}
+
+// Package registries
+
+func (s *Server) serveRegistries(w http.ResponseWriter, r *http.Request, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			registries := make([]map[string]any, 0)
+			for _, registry := range paginate(w, r, s.list("Registry", nil)) {
+				registries = append(registries, registryJSON(registry))
+			}
+			writeJSON(w, http.StatusOK, registries)
+		case http.MethodPost:
+			s.createRegistry(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	registry := s.find("Registry", byField("slug", rest[0]))
+	if len(rest) > 1 || registry == nil {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, registryJSON(registry))
+	case http.MethodPut, http.MethodPatch:
+		var body map[string]any
+		if !decode(w, r, &body) {
+			return
+		}
+		// renaming a registry derives its slug from the new name
+		if name, ok := body["name"].(string); ok && name != "" {
+			derived := slug.FromName(name)
+			if other := s.find("Registry", byField("slug", derived)); other != nil && other["id"] != registry["id"] {
+				validationFailed(w, "Name has already been taken")
+				return
+			}
+			registry["slug"] = derived
+		}
+		applyRegistryBody(registry, body)
+		writeJSON(w, http.StatusOK, registryJSON(registry))
+	case http.MethodDelete:
+		s.remove(registry["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createRegistry(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+	if !decode(w, r, &body) {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	ecosystem, _ := body["ecosystem"].(string)
+	if name == "" || ecosystem == "" {
+		validationFailed(w, "Name and ecosystem are required")
+		return
+	}
+	derived := slug.FromName(name)
+	if s.find("Registry", byField("slug", derived)) != nil {
+		validationFailed(w, "Name has already been taken")
+		return
+	}
+
+	registry := s.add("Registry", newUUID(), object{
+		"slug":        derived,
+		"ecosystem":   ecosystem,
+		"description": "",
+		"emoji":       "",
+		"color":       "",
+		"oidcPolicy":  "",
+		"teamIds":     []string{},
+	})
+	applyRegistryBody(registry, body)
+
+	writeJSON(w, http.StatusCreated, registryJSON(registry))
+}
+
+func applyRegistryBody(registry object, body map[string]any) {
+	for key, field := range map[string]string{
+		"name":        "name",
+		"description": "description",
+		"emoji":       "emoji",
+		"color":       "color",
+		"oidc_policy": "oidcPolicy",
+	} {
+		if v, ok := body[key].(string); ok {
+			registry[field] = v
+		}
+	}
+
+	if ids, ok := body["team_ids"].([]any); ok {
+		teamIDs := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if str, ok := id.(string); ok {
+				teamIDs = append(teamIDs, str)
+			}
+		}
+		registry["teamIds"] = teamIDs
+	}
+}
+
+func registryJSON(registry object) map[string]any {
+	return map[string]any{
+		"id":          registry["uuid"],
+		"graphql_id":  registry["id"],
+		"slug":        registry["slug"],
+		"name":        registry["name"],
+		"ecosystem":   registry["ecosystem"],
+		"description": registry["description"],
+		"emoji":       registry["emoji"],
+		"color":       registry["color"],
+		"oidc_policy": registry["oidcPolicy"],
+		"team_ids":    registry["teamIds"],
+	}
+}
+
+// paginate returns the page of items requested with the page and per_page query parameters, adding a Link header
+// pointing at the next page when there is one.
+func paginate(w http.ResponseWriter, r *http.Request, items []object) []object {
+	query := r.URL.Query()
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 30
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	if end < len(items) {
+		query.Set("page", strconv.Itoa(page+1))
+		next := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+	return items[start:end]
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"message": "Problems parsing JSON"})
+		return false
+	}
+	return true
+}
+
+func notFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]any{"message": "Not Found"})
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeJSON(w, http.StatusMethodNotAllowed, map[string]any{"message": "Method Not Allowed"})
+}
+
+func validationFailed(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{"message": "Validation Failed", "errors": []string{message}})
+}
@@ -0,0 +1,332 @@
+// Package fakebuildkite provides an in-memory stand-in for the Buildkite GraphQL and REST APIs.
+//
+// It implements the operations the provider uses so resources can be created, read, updated, imported and deleted
+// in tests without a real Buildkite organization. The server is not a complete implementation of either API; fields
+// and endpoints the provider does not use are not supported.
+package fakebuildkite
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Server is a fake Buildkite API backed by in-memory state. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, suitable for the provider's rest_url.
+	URL string
+	// Organization is the slug of the only organization the server knows about.
+	Organization string
+	// Token is the API token requests must authenticate with.
+	Token string
+
+	server *httptest.Server
+
+	mu     sync.Mutex
+	nodes  map[string]object
+	order  []string
+	org    object
+	viewer object
+	scopes []string
+	shapes []object
+}
+
+// NewServer starts a fake API for the given organization slug that accepts the given API token. Callers should Close
+// the server when they are done with it.
+func NewServer(organization, token string) *Server {
+	s := &Server{
+		Organization: organization,
+		Token:        token,
+		nodes:        make(map[string]object),
+		scopes: []string{
+			"graphql",
+			"read_pipelines", "write_pipelines",
+			"read_suites", "write_suites",
+			"read_registries", "write_registries", "delete_registries",
+		},
+	}
+	s.seed()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1", s.authenticated(s.serveGraphQL))
+	mux.HandleFunc("/v2/", s.authenticated(s.serveREST))
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	return s
+}
+
+// GraphQLURL returns the endpoint to use for the provider's graphql_url.
+func (s *Server) GraphQLURL() string {
+	return s.URL + "/v1"
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetScopes replaces the scopes reported for the API token.
+func (s *Server) SetScopes(scopes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scopes = scopes
+}
+
+// AddUser adds a member to the organization and returns the GraphQL ID of the user.
+func (s *Server) AddUser(name, email string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addUser(newUUID(), name, email)["id"].(string)
+}
+
+// AddAgent connects an agent to the cluster queue with the given GraphQL ID and returns the GraphQL ID of the agent.
+// Agents cannot be created through the API, they register themselves with an agent token.
+func (s *Server) AddAgent(queueID, name string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.node("ClusterQueue", queueID)
+	if err != nil {
+		return "", err
+	}
+	agent := s.add("Agent", newUUID(), object{
+		"name":            name,
+		"clusterQueue":    queue,
+		"connectionState": "connected",
+		"connectedAt":     now(),
+	})
+	return agent["id"].(string), nil
+}
+
+// AddJob adds a command job in the given state, such as "RUNNING", to the cluster queue with the given GraphQL ID and
+// returns the GraphQL ID of the job. Use Update to change its state.
+func (s *Server) AddJob(queueID, state string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.node("ClusterQueue", queueID)
+	if err != nil {
+		return "", err
+	}
+	job := s.add("JobTypeCommand", newUUID(), object{
+		"clusterQueue": queue,
+		"state":        state,
+	})
+	return job["id"].(string), nil
+}
+
+// AddInstanceShape adds a value to the HostedAgentInstanceShapeName enum, as when Buildkite releases a new shape for
+// hosted agents.
+func (s *Server) AddInstanceShape(name, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addInstanceShape(name, description)
+}
+
+// Delete removes the node with the given GraphQL ID, simulating a change made outside of Terraform. It reports whether
+// the node existed.
+func (s *Server) Delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.nodes[id]; !ok {
+		return false
+	}
+	s.remove(id)
+	return true
+}
+
+// Update sets fields on the node with the given GraphQL ID, simulating a change made outside of Terraform. It reports
+// whether the node existed.
+func (s *Server) Update(id string, fields map[string]any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	node, ok := s.nodes[id]
+	if !ok {
+		return false
+	}
+	for k, v := range fields {
+		node[k] = v
+	}
+	return true
+}
+
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", newUUID())
+		if r.Header.Get("Authorization") != "Bearer "+s.Token {
+			writeJSON(w, http.StatusUnauthorized, map[string]any{"message": "Authentication required. Please supply a valid API Access Token"})
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) seed() {
+	orgUUID := newUUID()
+	s.org = s.add("Organization", orgUUID, object{
+		"slug":                                  s.Organization,
+		"name":                                  s.Organization,
+		"allowedApiIpAddresses":                 "",
+		"membersRequireTwoFactorAuthentication": false,
+	})
+
+	// the user referenced by the team member acceptance tests
+	user := s.addUser("8db2920e-3c60-48a7-a3f8-2584be374bac", "Terraform Tester", "terraform@example.com")
+	s.viewer = object{"__typename": "Viewer", "user": user}
+
+	for _, shape := range [][2]string{
+		{"LINUX_AMD64_2X4", "Linux 2 vCPU x 4 GB Memory"},
+		{"LINUX_AMD64_4X16", "Linux 4 vCPU x 16 GB Memory"},
+		{"LINUX_AMD64_8X32", "Linux 8 vCPU x 32 GB Memory"},
+		{"LINUX_AMD64_16X64", "Linux 16 vCPU x 64 GB Memory"},
+		{"LINUX_ARM64_2X4", "Linux 2 vCPU x 4 GB Memory"},
+		{"LINUX_ARM64_4X16", "Linux 4 vCPU x 16 GB Memory"},
+		{"LINUX_ARM64_8X32", "Linux 8 vCPU x 32 GB Memory"},
+		{"LINUX_ARM64_16X64", "Linux 16 vCPU x 64 GB Memory"},
+		{"MACOS_M2_4X7", "macOS 4 vCPU x 7 GB Memory"},
+		{"MACOS_M2_6X14", "macOS 6 vCPU x 14 GB Memory"},
+		{"MACOS_M2_12X28", "macOS 12 vCPU x 28 GB Memory"},
+		{"MACOS_M4_12X56", "macOS 12 vCPU x 56 GB Memory"},
+	} {
+		s.addInstanceShape(shape[0], shape[1])
+	}
+}
+
+func (s *Server) addInstanceShape(name, description string) {
+	s.shapes = append(s.shapes, object{"__typename": "__EnumValue", "name": name, "description": description})
+}
+
+func (s *Server) addUser(uuid, name, email string) object {
+	user := s.add("User", uuid, object{
+		"name":  name,
+		"email": email,
+	})
+	s.add("OrganizationMember", newUUID(), object{
+		"user":         user,
+		"role":         "ADMIN",
+		"organization": s.org,
+	})
+	return user
+}
+
+// add stores a new node of the given type and returns it with its id, uuid and __typename populated.
+func (s *Server) add(typename, uuid string, fields object) object {
+	fields["__typename"] = typename
+	fields["id"] = nodeID(typename, uuid)
+	fields["uuid"] = uuid
+
+	s.nodes[fields["id"].(string)] = fields
+	s.order = append(s.order, fields["id"].(string))
+	return fields
+}
+
+// dependentTypes are the types of node that are removed along with the nodes they reference.
+var dependentTypes = map[string]bool{
+	"ClusterQueue":       true,
+	"ClusterToken":       true,
+	"OrganizationMember": true,
+	"PipelineSchedule":   true,
+	"TeamMember":         true,
+	"TeamPipeline":       true,
+	"TeamSuite":          true,
+}
+
+// remove deletes a node along with any nodes that only exist to link it to something else.
+func (s *Server) remove(id string) {
+	delete(s.nodes, id)
+	for i, v := range s.order {
+		if v == id {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+
+	for _, dependent := range s.list("", func(o object) bool {
+		if !dependentTypes[o["__typename"].(string)] {
+			return false
+		}
+		for _, key := range []string{"pipeline", "team", "suite", "cluster", "user"} {
+			if ref, ok := o[key].(object); ok && ref["id"] == id {
+				return true
+			}
+		}
+		return false
+	}) {
+		s.remove(dependent["id"].(string))
+	}
+}
+
+// list returns all nodes of a type, in the order they were created, that satisfy match. An empty typename matches
+// every type.
+func (s *Server) list(typename string, match func(object) bool) []object {
+	var nodes []object
+	for _, id := range s.order {
+		node := s.nodes[id]
+		if typename != "" && node["__typename"] != typename {
+			continue
+		}
+		if match == nil || match(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// find returns the first node of a type that satisfies match.
+func (s *Server) find(typename string, match func(object) bool) object {
+	nodes := s.list(typename, match)
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[0]
+}
+
+// node returns the node with the given id if it is of the expected type.
+func (s *Server) node(typename string, id any) (object, error) {
+	str, _ := id.(string)
+	node, ok := s.nodes[str]
+	if !ok || node["__typename"] != typename {
+		return nil, fmt.Errorf("No %s found with ID %q", strings.ToLower(typename), str)
+	}
+	return node, nil
+}
+
+func sortBy(nodes []object, field string) []object {
+	sorted := append([]object(nil), nodes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, _ := sorted[i][field].(string)
+		b, _ := sorted[j][field].(string)
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return sorted
+}
+
+func nodeID(typename, uuid string) string {
+	return base64.StdEncoding.EncodeToString([]byte(typename + "---" + uuid))
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func newToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x", b)
+}
@@ -0,0 +1,166 @@
+package fakebuildkite
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func graphqlRequest(t *testing.T, s *Server, token, query string, variables map[string]any) (int, map[string]any) {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return request(t, token, http.MethodPost, s.GraphQLURL(), body)
+}
+
+func request(t *testing.T, token, method, url string, body []byte) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result map[string]any
+	if resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.StatusCode, result
+}
+
+func TestServerRequiresToken(t *testing.T) {
+	t.Parallel()
+
+	s := NewServer("acme", "secret")
+	defer s.Close()
+
+	status, _ := graphqlRequest(t, s, "wrong", `query { viewer { user { name } } }`, nil)
+	if status != http.StatusUnauthorized {
+		t.Errorf("GraphQL: expected status %d, got %d", http.StatusUnauthorized, status)
+	}
+
+	status, _ = request(t, "wrong", http.MethodGet, s.URL+"/v2/meta", nil)
+	if status != http.StatusUnauthorized {
+		t.Errorf("REST: expected status %d, got %d", http.StatusUnauthorized, status)
+	}
+}
+
+func TestServerPipelineLifecycle(t *testing.T) {
+	t.Parallel()
+
+	s := NewServer("acme", "secret")
+	defer s.Close()
+
+	_, resp := graphqlRequest(t, s, s.Token, `
+		query { organization(slug: "acme") { id } }`, nil)
+	orgID := resp["data"].(map[string]any)["organization"].(map[string]any)["id"]
+
+	_, resp = graphqlRequest(t, s, s.Token, `
+		mutation create($input: PipelineCreateInput!) {
+			pipelineCreate(input: $input) { pipeline { id slug name } }
+		}`, map[string]any{"input": map[string]any{
+		"organizationId": orgID,
+		"name":           "My Pipeline",
+		"repository":     map[string]any{"url": "https://github.com/acme/app.git"},
+		"steps":          map[string]any{"yaml": "steps: []"},
+	}})
+	if errs, ok := resp["errors"]; ok {
+		t.Fatalf("unexpected errors creating pipeline: %v", errs)
+	}
+	pipeline := resp["data"].(map[string]any)["pipelineCreate"].(map[string]any)["pipeline"].(map[string]any)
+	if pipeline["slug"] != "my-pipeline" {
+		t.Errorf("expected slug %q, got %q", "my-pipeline", pipeline["slug"])
+	}
+
+	// changing the slug through REST keeps the old slug resolving
+	body, _ := json.Marshal(map[string]any{"slug": "custom"})
+	status, _ := request(t, s.Token, http.MethodPatch, s.URL+"/v2/organizations/acme/pipelines/my-pipeline", body)
+	if status != http.StatusOK {
+		t.Fatalf("expected status %d updating slug, got %d", http.StatusOK, status)
+	}
+	for _, slug := range []string{"acme/custom", "acme/my-pipeline"} {
+		_, resp = graphqlRequest(t, s, s.Token, `
+			query get($slug: ID!) { pipeline(slug: $slug) { id } }`, map[string]any{"slug": slug})
+		got := resp["data"].(map[string]any)["pipeline"]
+		if diff := cmp.Diff(map[string]any{"id": pipeline["id"]}, got); diff != "" {
+			t.Errorf("pipeline %s mismatch (-want +got):\n%s", slug, diff)
+		}
+	}
+
+	if !s.Delete(pipeline["id"].(string)) {
+		t.Fatal("expected the pipeline to exist")
+	}
+	_, resp = graphqlRequest(t, s, s.Token, `
+		query get($id: ID!) { node(id: $id) { id } }`, map[string]any{"id": pipeline["id"]})
+	if got := resp["data"].(map[string]any)["node"]; got != nil {
+		t.Errorf("expected the pipeline to be deleted, got %v", got)
+	}
+}
+
+func TestConnection(t *testing.T) {
+	t.Parallel()
+
+	nodes := []object{{"id": "a"}, {"id": "b"}, {"id": "c"}}
+
+	testCases := map[string]struct {
+		args        map[string]any
+		ids         []string
+		hasNextPage bool
+	}{
+		"all": {
+			args: map[string]any{},
+			ids:  []string{"a", "b", "c"},
+		},
+		"first": {
+			args:        map[string]any{"first": float64(2)},
+			ids:         []string{"a", "b"},
+			hasNextPage: true,
+		},
+		"after": {
+			args: map[string]any{"first": float64(2), "after": "1"},
+			ids:  []string{"c"},
+		},
+		"past the end": {
+			args: map[string]any{"after": "5"},
+			ids:  []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := connection("Pipeline", nodes, testCase.args)
+
+			ids := []string{}
+			for _, edge := range conn["edges"].([]object) {
+				ids = append(ids, edge["node"].(object)["id"].(string))
+			}
+			if diff := cmp.Diff(testCase.ids, ids); diff != "" {
+				t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+			}
+			if got := conn["pageInfo"].(object)["hasNextPage"]; got != testCase.hasNextPage {
+				t.Errorf("expected hasNextPage %v, got %v", testCase.hasNextPage, got)
+			}
+			if conn["count"] != len(nodes) {
+				t.Errorf("expected count %d, got %v", len(nodes), conn["count"])
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type nullUnlessModifier struct {
+	attr string
+}
+
+// Description implements planmodifier.String.
+func (nullUnlessModifier) Description(context.Context) string {
+	return "The value of this attribute is null unless the dependent boolean attribute is true."
+}
+
+// MarkdownDescription implements planmodifier.String.
+func (nullUnlessModifier) MarkdownDescription(context.Context) string {
+	return "The value of this attribute is null unless the dependent boolean attribute is true."
+}
+
+// PlanModifyString implements planmodifier.String.
+func (mod nullUnlessModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var enabled types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(mod.attr), &enabled)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do nothing until the dependent attribute is known.
+	if enabled.IsUnknown() || enabled.IsNull() || enabled.ValueBool() {
+		return
+	}
+
+	resp.PlanValue = types.StringNull()
+}
+
+// NullUnless plans a computed attribute as null when the boolean attribute attr is false
+func NullUnless(attr string) planmodifier.String {
+	return nullUnlessModifier{attr}
+}
@@ -0,0 +1,91 @@
+package planmodifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNullUnlessModifier(t *testing.T) {
+	t.Parallel()
+
+	storeSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"store": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+	storePlan := func(store tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"store": tftypes.Bool,
+				},
+			}, map[string]tftypes.Value{
+				"store": store,
+			}),
+			Schema: storeSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		plan     tfsdk.Plan
+		expected *planmodifier.StringResponse
+	}{
+		"enabled": {
+			plan: storePlan(tftypes.NewValue(tftypes.Bool, true)),
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"disabled": {
+			plan: storePlan(tftypes.NewValue(tftypes.Bool, false)),
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"unknown": {
+			// the dependent attribute may come from another resource
+			plan: storePlan(tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)),
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"destroy": {
+			plan: tfsdk.Plan{
+				Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"store": tftypes.Bool}}, nil),
+				Schema: storeSchema,
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			resp := &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			}
+
+			NullUnless("store").PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Plan:      testCase.plan,
+				PlanValue: types.StringValue("test"),
+			}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
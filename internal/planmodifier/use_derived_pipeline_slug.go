@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/buildkite/terraform-provider-buildkite/internal/slug"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,18 +14,23 @@ type useDerivedPipelineSlugModifier struct{}
 
 // Description implements planmodifier.String.
 func (useDerivedPipelineSlugModifier) Description(context.Context) string {
-	return "Once set, the value of this attribute in state will only change if the dependent attribute changes."
+	return "Derives the slug from the name unless it is set, and only changes it when the name changes."
 }
 
 // MarkdownDescription implements planmodifier.String.
 func (useDerivedPipelineSlugModifier) MarkdownDescription(context.Context) string {
-	return "Once set, the value of this attribute in state will only change if the dependent attribute changes."
+	return "Derives the slug from the name unless it is set, and only changes it when the name changes."
 }
 
 // PlanModifyString implements planmodifier.String.
 func (m useDerivedPipelineSlugModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// Do nothing if there is no state value (new resource creation)
+	// A new resource without a user-defined slug has the slug derived from its name
 	if req.StateValue.IsNull() {
+		if req.ConfigValue.IsNull() {
+			var planValueName types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planValueName)...)
+			resp.PlanValue = derivedSlug(planValueName)
+		}
 		return
 	}
 
@@ -57,9 +63,9 @@ func (m useDerivedPipelineSlugModifier) PlanModifyString(ctx context.Context, re
 			resp.PlanValue = types.StringUnknown()
 			return
 		}
-		// Return unknown if name is changing (re-generate slug from API)
+		// Derive the new slug if name is changing (re-generated by the API)
 		if planValueName != stateValueName {
-			resp.PlanValue = types.StringUnknown()
+			resp.PlanValue = derivedSlug(types.StringValue(planValueName))
 			return
 		} else {
 			// Name not changed, Config provided matches value in state, set value to state (NoOp)
@@ -69,6 +75,14 @@ func (m useDerivedPipelineSlugModifier) PlanModifyString(ctx context.Context, re
 	}
 }
 
+// derivedSlug returns the slug the API will derive from the name, or unknown if that cannot be predicted
+func derivedSlug(name types.String) types.String {
+	if name.IsUnknown() || name.IsNull() || !slug.Predictable(name.ValueString()) {
+		return types.StringUnknown()
+	}
+	return types.StringValue(slug.FromName(name.ValueString()))
+}
+
 func UseDerivedPipelineSlug() planmodifier.String {
 	return useDerivedPipelineSlugModifier{}
 }
@@ -0,0 +1,206 @@
+package resourcevalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/buildkite/go-pipeline"
+	"github.com/buildkite/go-pipeline/warning"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"gopkg.in/yaml.v3"
+)
+
+// deprecatedStepKeys maps deprecated step keys to the keys that replace them
+var deprecatedStepKeys = map[string]string{
+	"agent_query_rules": "agents",
+	"waiter":            "wait",
+	"manual":            "block",
+}
+
+// deprecatedStepTypes maps deprecated values of a step's type to the types that replace them
+var deprecatedStepTypes = map[string]string{
+	"script": "command",
+	"waiter": "wait",
+	"manual": "block",
+}
+
+// PipelineStepsValidator validates pipeline steps in YAML format with the same parser the agent uses, so mistakes
+// are reported when planning rather than when a build runs. Steps the parser can't make sense of are only warned
+// about, as the API accepts them and the parser still returns a usable pipeline.
+type PipelineStepsValidator struct{}
+
+func (v *PipelineStepsValidator) Description(ctx context.Context) string {
+	return "Validates pipeline steps in YAML format"
+}
+
+func (v *PipelineStepsValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates pipeline steps in YAML format"
+}
+
+func (v *PipelineStepsValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	src := req.ConfigValue.ValueString()
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(src), &doc); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid pipeline YAML",
+			strings.TrimPrefix(err.Error(), "yaml: "),
+		)
+		return
+	}
+
+	_, err := pipeline.Parse(strings.NewReader(src))
+	parseWarning := warning.As(err)
+	if err != nil && parseWarning == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid pipeline steps", err.Error())
+		return
+	}
+
+	problems := 0
+	if steps := stepsNode(&doc); steps != nil {
+		problems = v.validateSteps(req, resp, steps, parseWarning != nil)
+	}
+
+	// the problem could not be located in the steps, such as when there are none or they use YAML aliases
+	if parseWarning != nil && problems == 0 {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Problem with pipeline steps", parseWarning.Error())
+	}
+}
+
+// validateSteps warns about problems with each step of a sequence at its position, returning how many problems were
+// reported. Steps are only parsed individually when checkSteps is set, as the parser warned about the pipeline.
+func (v *PipelineStepsValidator) validateSteps(
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+	steps *yaml.Node,
+	checkSteps bool,
+) int {
+	problems := 0
+	for _, step := range steps.Content {
+		nested := 0
+		if step.Kind == yaml.MappingNode {
+			v.warnDeprecated(req, resp, step)
+
+			if group := mappingValue(step, "group"); group != nil {
+				if groupSteps := mappingValue(step, "steps"); groupSteps != nil && groupSteps.Kind == yaml.SequenceNode {
+					nested = v.validateSteps(req, resp, groupSteps, checkSteps)
+					problems += nested
+				}
+			}
+		}
+
+		if !checkSteps || nested > 0 {
+			continue
+		}
+		if err := parseStep(step); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Problem with pipeline step",
+				fmt.Sprintf("line %d, column %d: %s", step.Line, step.Column, strings.Join(leafErrors(err), "; ")),
+			)
+			problems++
+		}
+	}
+	return problems
+}
+
+func (v *PipelineStepsValidator) warnDeprecated(req validator.StringRequest, resp *validator.StringResponse, step *yaml.Node) {
+	for i := 0; i+1 < len(step.Content); i += 2 {
+		key, value := step.Content[i], step.Content[i+1]
+
+		if replacement, ok := deprecatedStepKeys[key.Value]; ok {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Deprecated pipeline step key",
+				fmt.Sprintf("line %d, column %d: the %q key is deprecated, use %q instead", key.Line, key.Column, key.Value, replacement),
+			)
+		}
+
+		if key.Value == "type" {
+			if replacement, ok := deprecatedStepTypes[value.Value]; ok {
+				resp.Diagnostics.AddAttributeWarning(
+					req.Path,
+					"Deprecated pipeline step type",
+					fmt.Sprintf("line %d, column %d: the %q step type is deprecated, use %q instead", value.Line, value.Column, value.Value, replacement),
+				)
+			}
+		}
+	}
+}
+
+// parseStep parses a single step on its own, returning any error or warning from the parser
+func parseStep(step *yaml.Node) error {
+	if step.Kind == yaml.ScalarNode {
+		_, err := pipeline.NewScalarStep(step.Value)
+		return err
+	}
+
+	src, err := yaml.Marshal(&yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "steps"},
+			{Kind: yaml.SequenceNode, Content: []*yaml.Node{step}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = pipeline.Parse(strings.NewReader(string(src)))
+	return err
+}
+
+// stepsNode returns the sequence of steps in a pipeline document, which is either the document itself or the value
+// of its steps key
+func stepsNode(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+
+	root := doc.Content[0]
+	switch root.Kind {
+	case yaml.SequenceNode:
+		return root
+	case yaml.MappingNode:
+		if steps := mappingValue(root, "steps"); steps != nil && steps.Kind == yaml.SequenceNode {
+			return steps
+		}
+	}
+	return nil
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// leafErrors returns the messages of the errors a parser warning wraps, without the context the parser adds
+func leafErrors(err error) []string {
+	w := warning.As(err)
+	if w == nil {
+		return []string{err.Error()}
+	}
+
+	var messages []string
+	for _, wrapped := range w.Unwrap() {
+		if wrapped == nil {
+			continue
+		}
+		messages = append(messages, leafErrors(wrapped)...)
+	}
+	return messages
+}
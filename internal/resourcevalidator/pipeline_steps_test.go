@@ -0,0 +1,108 @@
+package resourcevalidator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPipelineStepsValidator(t *testing.T) {
+	testCases := map[string]struct {
+		steps    types.String
+		errors   []string
+		warnings []string
+	}{
+		"valid steps": {
+			steps: types.StringValue(heredoc.Doc(`
+				steps:
+				  - label: ":pipeline:"
+				    command: buildkite-agent pipeline upload
+				  - wait
+				  - group: tests
+				    steps:
+				      - command: make test
+			`)),
+		},
+		"unknown value": {
+			steps: types.StringUnknown(),
+		},
+		"YAML syntax error": {
+			steps:  types.StringValue("steps:\n  - command: [echo\n"),
+			errors: []string{"line 1: did not find expected"},
+		},
+		"unknown step type": {
+			steps: types.StringValue(heredoc.Doc(`
+				steps:
+				  - command: make test
+				  - type: comand
+				    comand: make lint
+			`)),
+			warnings: []string{`line 3, column 5: unknown step type "comand"`},
+		},
+		"step type cannot be inferred": {
+			steps: types.StringValue(heredoc.Doc(`
+				steps:
+				  - group: tests
+				    steps:
+				      - label: test
+				        comand: make test
+			`)),
+			warnings: []string{"line 4, column 9: cannot infer step type"},
+		},
+		"invalid scalar step": {
+			steps:    types.StringValue("steps:\n  - make test\n"),
+			warnings: []string{"line 2, column 5:"},
+		},
+		"no steps": {
+			steps:    types.StringValue("env:\n  FOO: bar\n"),
+			warnings: []string{"pipeline contains no steps"},
+		},
+		"deprecated keys": {
+			steps: types.StringValue(heredoc.Doc(`
+				steps:
+				  - command: make test
+				    agent_query_rules: ["queue=default"]
+				  - type: waiter
+			`)),
+			warnings: []string{
+				`line 3, column 5: the "agent_query_rules" key is deprecated, use "agents" instead`,
+				`line 4, column 11: the "waiter" step type is deprecated, use "wait" instead`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			(&PipelineStepsValidator{}).ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("steps"),
+				ConfigValue: testCase.steps,
+			}, resp)
+
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != len(testCase.errors) {
+				t.Fatalf("expected %d errors, got %v", len(testCase.errors), errors)
+			}
+			for i, expected := range testCase.errors {
+				if !strings.Contains(errors[i].Detail(), expected) {
+					t.Errorf("expected error containing %q, got %q", expected, errors[i].Detail())
+				}
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != len(testCase.warnings) {
+				t.Fatalf("expected %d warnings, got %v", len(testCase.warnings), warnings)
+			}
+			for i, expected := range testCase.warnings {
+				if !strings.Contains(warnings[i].Detail(), expected) {
+					t.Errorf("expected warning containing %q, got %q", expected, warnings[i].Detail())
+				}
+			}
+		})
+	}
+}
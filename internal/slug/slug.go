@@ -0,0 +1,41 @@
+// Package slug derives slugs from names in the same way as the Buildkite API does for pipelines and registries.
+package slug
+
+import "strings"
+
+// MaxLength is the longest slug the Buildkite API allows.
+const MaxLength = 100
+
+// FromName derives a slug from a name. Letters are lowercased, and each run of any other characters, such as spaces,
+// becomes a single hyphen.
+func FromName(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			hyphen = false
+		case b.Len() > 0 && !hyphen:
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// Predictable reports whether the API is certain to derive the same slug as FromName. The API may transliterate
+// characters other than ASCII letters, numbers, spaces and hyphens, and has to make up a slug when the derived one is
+// empty or too long, so names like that are not predictable.
+func Predictable(name string) bool {
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == ' ', r == '-':
+		default:
+			return false
+		}
+	}
+
+	slug := FromName(name)
+	return slug != "" && len(slug) <= MaxLength
+}
@@ -0,0 +1,63 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFromName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Hello there friend":      "hello-there-friend",
+		"Hello    There Friend":   "hello-there-friend",
+		"  leading and trailing ": "leading-and-trailing",
+		"already-a-slug":          "already-a-slug",
+		"Deploy -- Production":    "deploy-production",
+		"release_v1.2":            "release-v1-2",
+		"🚀":                       "",
+		// slugs the Buildkite API derived for these names, also checked against the API by the pipeline acceptance tests
+		"TesT --- PipeLine - 4k2x9q":  "test-pipeline-4k2x9q",
+		"Deploy to Production 4k2x9q": "deploy-to-production-4k2x9q",
+		"Build -- Test  4k2x9q":       "build-test-4k2x9q",
+		"already-a-slug-4k2x9q":       "already-a-slug-4k2x9q",
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := FromName(name); actual != expected {
+				t.Errorf("expected %q, got %q", expected, actual)
+			}
+		})
+	}
+}
+
+func TestPredictable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"Hello there friend":        true,
+		"already-a-slug":            true,
+		"release_v1.2":              false,
+		"Café":                      false,
+		"🚀":                         false,
+		"---":                       false,
+		strings.Repeat("a", 100):    true,
+		strings.Repeat("a", 101):    false,
+		strings.Repeat("ab ", 33):   true,
+		strings.Repeat("ab ", 34):   false,
+		"Trailing spaces are fine ": true,
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := Predictable(name); actual != expected {
+				t.Errorf("expected %t, got %t", expected, actual)
+			}
+		})
+	}
+}
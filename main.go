@@ -1,8 +1,13 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/buildkite/terraform-provider-buildkite/buildkite"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -15,6 +20,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -35,3 +47,44 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// export writes Terraform configuration and import blocks for the resources of an existing organization
+func export(args []string) error {
+	var opts buildkite.ExportOptions
+	var resources, out string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [flags]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes Terraform configuration and import blocks for the resources of an existing organization.")
+		fmt.Fprintln(flags.Output(), "The API token is read from BUILDKITE_API_TOKEN.")
+		fmt.Fprintln(flags.Output(), "Test suites and their team access are not exported, as buildkite_test_suite cannot be imported.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.Organization, "organization", "", "the slug of the organization, defaults to BUILDKITE_ORGANIZATION_SLUG")
+	flags.StringVar(&resources, "resources", "", "comma separated resource types to export, such as buildkite_pipeline,buildkite_team (default all supported types)")
+	flags.StringVar(&opts.Team, "team", "", "only export the team with this slug, its members, and the pipelines and registries it can access")
+	flags.StringVar(&opts.Cluster, "cluster", "", "only export the cluster with this name, its queues and its pipelines")
+	flags.StringVar(&out, "out", "", "the file to write the configuration to (default standard output)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if resources != "" {
+		opts.ResourceTypes = strings.Split(resources, ",")
+	}
+	opts.UserAgent = "terraform-provider-buildkite/" + version + " (export)"
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return buildkite.Export(context.Background(), opts, w)
+}